@@ -76,7 +76,7 @@ func (s *AuthService) Login(ctx context.Context, req *authpb.LoginRequest) (*aut
 		return nil, status.Error(codes.Unauthenticated, "Invalid password")
 	}
 
-	token, err := services.GenerateJWT(user.ID)
+	token, err := services.GenerateJWT(user.ID, user.Role, user.OrgID)
 	if err != nil {
 		s.l.Err(err).Msg("failed to generate token")
 		return nil, status.Error(codes.Internal, "failed to generate token")
@@ -86,6 +86,50 @@ func (s *AuthService) Login(ctx context.Context, req *authpb.LoginRequest) (*aut
 	return &authpb.LoginResponse{Token: token}, nil
 }
 
+// GetUser looks a user up by ID so other services can check that it exists.
+func (s *AuthService) GetUser(ctx context.Context, req *authpb.GetUserRequest) (*authpb.GetUserResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id must be positive")
+	}
+
+	user, err := s.u.GetUserByID(req.Id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		s.l.Err(err).Msg("failed to get user")
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	return &authpb.GetUserResponse{Id: user.ID, Username: user.Username}, nil
+}
+
+// SwitchOrg makes an organization the user is a member of active, or none
+// with a zero org_id, and issues a token carrying it.
+func (s *AuthService) SwitchOrg(ctx context.Context, req *authpb.SwitchOrgRequest) (*authpb.SwitchOrgResponse, error) {
+	if req.UserId <= 0 || req.OrgId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id must be positive and org id must not be negative")
+	}
+
+	user, err := s.u.SwitchOrg(req.UserId, req.OrgId)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotOrgMember) {
+			return nil, status.Error(codes.NotFound, "Organization not found")
+		}
+		s.l.Err(err).Msg("failed to switch organization")
+		return nil, status.Error(codes.Internal, "failed to switch organization")
+	}
+
+	token, err := services.GenerateJWT(user.ID, user.Role, user.OrgID)
+	if err != nil {
+		s.l.Err(err).Msg("failed to generate token")
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	s.l.Info().Int64("userID", user.ID).Int64("orgID", user.OrgID).Msg("User switched organization")
+	return &authpb.SwitchOrgResponse{Token: token}, nil
+}
+
 func validateAndCleanCredentials(username, password string) (string, string, error) {
 	username = strings.TrimSpace(username)
 	password = strings.TrimSpace(password)
@@ -1,7 +1,17 @@
 package entity
 
+// User roles. Supervisors see the calls of the whole team in statistics.
+const (
+	RoleOperator   = "operator"
+	RoleSupervisor = "supervisor"
+)
+
 type User struct {
 	ID       int64  `json:"id"`
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Role     string `json:"role"`
+	// OrgID is the organization the tokens of the user are issued for, zero if
+	// none.
+	OrgID int64 `json:"org_id"`
 }
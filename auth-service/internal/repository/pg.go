@@ -8,6 +8,8 @@ import (
 type Repository interface {
 	SaveUser(entity.User) error
 	GetUser(string) (*entity.User, error)
+	GetUserByID(int64) (*entity.User, error)
+	SwitchOrg(int64, int64) (*entity.User, error)
 }
 
 type AuthRepo struct {
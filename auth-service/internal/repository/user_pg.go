@@ -10,8 +10,12 @@ import (
 )
 
 const (
-	querySaveUser = `INSERT INTO users (username, password_hash) VALUES ($1, $2)`
-	queryGetUser  = `SELECT id, username, password_hash FROM users WHERE username = $1 LIMIT 1`
+	querySaveUser    = `INSERT INTO users (username, password_hash) VALUES ($1, $2)`
+	queryGetUser     = `SELECT id, username, password_hash, role, COALESCE(org_id, 0) FROM users WHERE username = $1 LIMIT 1`
+	queryGetUserByID = `SELECT id, username, password_hash, role, COALESCE(org_id, 0) FROM users WHERE id = $1`
+	// querySwitchOrg makes organization $2 active for user $1 if the user is
+	// a member of it, or leaves the user without one if $2 is zero.
+	querySwitchOrg = `UPDATE users SET org_id = NULLIF($2, 0) WHERE id = $1 AND ($2 = 0 OR EXISTS (SELECT 1 FROM memberships WHERE org_id = $2 AND user_id = $1)) RETURNING id, username, password_hash, role, COALESCE(org_id, 0)`
 )
 
 var ErrUserAlreadyExists = errors.New("user already exists")
@@ -33,7 +37,7 @@ func (r *AuthRepo) GetUser(login string) (*entity.User, error) {
 	ctx := context.Background()
 
 	var user entity.User
-	err := r.Pool.QueryRow(ctx, queryGetUser, login).Scan(&user.ID, &user.Username, &user.Password)
+	err := r.Pool.QueryRow(ctx, queryGetUser, login).Scan(&user.ID, &user.Username, &user.Password, &user.Role, &user.OrgID)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, nil
@@ -43,3 +47,35 @@ func (r *AuthRepo) GetUser(login string) (*entity.User, error) {
 
 	return &user, nil
 }
+
+func (r *AuthRepo) GetUserByID(id int64) (*entity.User, error) {
+	ctx := context.Background()
+
+	var user entity.User
+	err := r.Pool.QueryRow(ctx, queryGetUserByID, id).Scan(&user.ID, &user.Username, &user.Password, &user.Role, &user.OrgID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	return &user, nil
+}
+
+// SwitchOrg sets the organization the tokens of the user are issued for and
+// returns the user, or nil if the user is not a member of it.
+func (r *AuthRepo) SwitchOrg(userID, orgID int64) (*entity.User, error) {
+	ctx := context.Background()
+
+	var user entity.User
+	err := r.Pool.QueryRow(ctx, querySwitchOrg, userID, orgID).Scan(&user.ID, &user.Username, &user.Password, &user.Role, &user.OrgID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to switch organization: %w", err)
+	}
+
+	return &user, nil
+}
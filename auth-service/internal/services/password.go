@@ -21,10 +21,16 @@ func CheckPassword(plainPassword, hashedPassword string) bool {
 	return err == nil
 }
 
-func GenerateJWT(userID int64) (string, error) {
+// GenerateJWT issues a token of the user. It carries the organization active
+// in the session in org_id, unless orgID is zero.
+func GenerateJWT(userID int64, role string, orgID int64) (string, error) {
 	claims := jwt.MapClaims{
-		"id":  userID,
-		"exp": time.Now().Add(time.Hour * 24 * 7).Unix(),
+		"id":   userID,
+		"role": role,
+		"exp":  time.Now().Add(time.Hour * 24 * 7).Unix(),
+	}
+	if orgID != 0 {
+		claims["org_id"] = orgID
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -10,6 +10,7 @@ import (
 
 var ErrUserNotFound = errors.New("user not found")
 var ErrUserAlreadyExists = errors.New("user already exists")
+var ErrNotOrgMember = errors.New("user is not a member of the organization")
 
 func (uc *UseCase) Create(user entity.User) error {
 	err := uc.repo.SaveUser(user)
@@ -31,3 +32,31 @@ func (uc *UseCase) GetUser(login string) (*entity.User, error) {
 
 	return user, nil
 }
+
+func (uc *UseCase) GetUserByID(id int64) (*entity.User, error) {
+	user, err := uc.repo.GetUserByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// SwitchOrg makes an organization of the user active, or none if orgID is
+// zero, and returns the user.
+func (uc *UseCase) SwitchOrg(userID, orgID int64) (*entity.User, error) {
+	user, err := uc.repo.SwitchOrg(userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to switch organization: %w", err)
+	}
+
+	if user == nil {
+		return nil, ErrNotOrgMember
+	}
+
+	return user, nil
+}
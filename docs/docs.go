@@ -16,42 +16,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/calls": {
-            "get": {
-                "description": "Retrieves a list of calls belonging to the authenticated user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "calls"
-                ],
-                "summary": "Get user calls",
-                "responses": {
-                    "200": {
-                        "description": "List of calls",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/entity.CallResponse"
-                            }
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/apierrors.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/apierrors.Response"
-                        }
-                    }
-                }
-            },
+        "/auth/switch-org": {
             "post": {
-                "description": "Saves a new call with client name, phone number, and description",
+                "description": "Makes an organization the authenticated user is a member of active, or none with org_id 0, and returns a new token carrying it in the org_id claim. Requests made with the new token see the calls of the organization, and the calls they create belong to it; other tokens keep their organization. Later logins start in the organization as well",
                 "consumes": [
                     "application/json"
                 ],
@@ -59,25 +26,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "calls"
+                    "auth"
                 ],
-                "summary": "Create a new call",
+                "summary": "Switch organization",
                 "parameters": [
                     {
-                        "description": "Call data",
+                        "description": "Organization",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.CallDTO"
+                            "$ref": "#/definitions/entity.SwitchOrgDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "JWT token",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -92,6 +62,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -101,34 +77,129 @@ const docTemplate = `{
                 }
             }
         },
-        "/calls/{id}": {
+        "/calls": {
             "get": {
-                "description": "Retrieves details of a specific call belonging to the authenticated user",
+                "description": "Retrieves a page of calls created by or assigned to the authenticated user using keyset pagination",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "calls"
                 ],
-                "summary": "Get user call by ID",
+                "summary": "Get user calls",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Call ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of phone number",
+                        "name": "phone_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of client name",
+                        "name": "client_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag name (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "me"
+                        ],
+                        "type": "string",
+                        "description": "Only calls assigned to the authenticated user",
+                        "name": "assigned_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "low",
+                            "normal",
+                            "high",
+                            "critical"
+                        ],
+                        "type": "string",
+                        "description": "Filter by priority",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ok",
+                            "at_risk",
+                            "breached"
+                        ],
+                        "type": "string",
+                        "description": "Filter by SLA state",
+                        "name": "sla",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "client_name",
+                            "status",
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Call details",
+                        "description": "Page of calls",
                         "schema": {
-                            "$ref": "#/definitions/entity.CallResponse"
+                            "$ref": "#/definitions/entity.CallsListResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid call ID",
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
@@ -139,12 +210,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
-                    "404": {
-                        "description": "Call not found",
-                        "schema": {
-                            "$ref": "#/definitions/apierrors.Response"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -153,30 +218,44 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes a call belonging to the authenticated user by its ID",
+            "post": {
+                "description": "Saves a new call with client name, phone number, and description. A call whose phone number already has an open call created within the duplicate window is refused unless force is set",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "calls"
                 ],
-                "summary": "Delete call",
+                "summary": "Create a new call",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Call ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Call data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallDTO"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create the call even if it looks like a duplicate",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
-                        "description": "Invalid call ID",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
@@ -187,10 +266,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
-                    "404": {
-                        "description": "Call not found or does not belong to user",
+                    "409": {
+                        "description": "Duplicate of an open call",
                         "schema": {
-                            "$ref": "#/definitions/apierrors.Response"
+                            "$ref": "#/definitions/apierrors.DuplicateResponse"
                         }
                     },
                     "500": {
@@ -202,9 +281,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/calls/{id}/status": {
-            "put": {
-                "description": "Updates the status (open or closed) of a specific user call",
+        "/calls/bulk": {
+            "post": {
+                "description": "Creates up to 100 calls. Every item is validated like POST /calls. In atomic mode (default) nothing is created if any item is invalid; in best_effort mode the valid items are created. Unless force is set, an item repeating a recent open call or an earlier item fails as a duplicate, like in POST /calls",
                 "consumes": [
                     "application/json"
                 ],
@@ -212,33 +291,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "calls"
+                    "bulk"
                 ],
-                "summary": "Update call status",
+                "summary": "Bulk create calls",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Call ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "New status",
+                        "description": "Calls to create",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.UpdateCallStatusDTO"
+                            "$ref": "#/definitions/entity.BulkCreateCallsDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "Per-item results",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BulkResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid input or status value",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
@@ -249,10 +324,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
-                    "404": {
-                        "description": "Call not found or does not belong to user",
+                    "422": {
+                        "description": "Atomic request rolled back",
                         "schema": {
-                            "$ref": "#/definitions/apierrors.Response"
+                            "$ref": "#/definitions/entity.BulkResponse"
                         }
                     },
                     "500": {
@@ -264,9 +339,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
+        "/calls/bulk/delete": {
             "post": {
-                "description": "Authenticates a user and returns a JWT token",
+                "description": "Moves up to 100 calls to the trash. In atomic mode (default) nothing is deleted if any call is not found; in best_effort mode the found calls are deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -274,42 +349,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "bulk"
                 ],
-                "summary": "User login",
+                "summary": "Bulk delete calls",
                 "parameters": [
                     {
-                        "description": "User login credentials",
+                        "description": "Call IDs",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.AuthRequest"
+                            "$ref": "#/definitions/entity.BulkDeleteDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT token",
+                        "description": "Per-item results",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/entity.BulkResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
                     "401": {
-                        "description": "Invalid username or password",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
+                    "422": {
+                        "description": "Atomic request rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BulkResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -319,9 +397,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
+        "/calls/bulk/status": {
             "post": {
-                "description": "Registers a new user with username and password",
+                "description": "Changes the status of up to 100 calls following the status workflow. In atomic mode (default) nothing is changed if any item fails; in best_effort mode the allowed changes are applied",
                 "consumes": [
                     "application/json"
                 ],
@@ -329,36 +407,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "bulk"
                 ],
-                "summary": "Register user",
+                "summary": "Bulk update call status",
                 "parameters": [
                     {
-                        "description": "User registration data",
+                        "description": "Status changes",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entity.AuthRequest"
+                            "$ref": "#/definitions/entity.BulkUpdateStatusDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "Per-item results",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BulkResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/apierrors.Response"
                         }
                     },
+                    "422": {
+                        "description": "Atomic request rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BulkResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -367,67 +454,4207 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apierrors.Response": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                }
-            }
         },
-        "entity.AuthRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string"
-                },
-                "username": {
-                    "type": "string"
+        "/calls/callbacks/upcoming": {
+            "get": {
+                "description": "Returns the open calls visible to the authenticated user with a callback due within the given time, overdue callbacks included, the earliest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "callbacks"
+                ],
+                "summary": "Get upcoming callbacks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time ahead as a Go duration, from 1m to 720h, 24h by default",
+                        "name": "within",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of calls, 50 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Calls with upcoming callbacks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.CallResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
                 }
             }
         },
-        "entity.CallDTO": {
-            "type": "object",
-            "required": [
-                "client_name",
-                "description",
+        "/calls/export": {
+            "get": {
+                "description": "Streams all calls created by or assigned to the authenticated user as CSV, JSON Lines or XLSX. Accepts the filters and sort order of GET /calls; limit and cursor are ignored",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Export user calls",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "jsonl",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of phone number",
+                        "name": "phone_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of client name",
+                        "name": "client_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag name (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "me"
+                        ],
+                        "type": "string",
+                        "description": "Only calls assigned to the authenticated user",
+                        "name": "assigned_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "low",
+                            "normal",
+                            "high",
+                            "critical"
+                        ],
+                        "type": "string",
+                        "description": "Filter by priority",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ok",
+                            "at_risk",
+                            "breached"
+                        ],
+                        "type": "string",
+                        "description": "Filter by SLA state",
+                        "name": "sla",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "client_name",
+                            "status",
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported calls",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/import": {
+            "post": {
+                "description": "Imports calls from a CSV or XLSX file (first sheet) with a header row. Every row is validated like POST /calls; invalid rows are skipped and listed in the report, valid rows are imported in one transaction. Unless force is set, rows repeating a recent open call or an earlier row of the file are skipped as duplicates and listed in the report too. The optional columns are priority, status (status code) and created_at (YYYY-MM-DD[ HH:MM[:SS]], DD.MM.YYYY[ HH:MM[:SS]] or RFC 3339). In dry-run mode the file is only validated",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import"
+                ],
+                "summary": "Import calls",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV or XLSX file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON object mapping call fields to column headers, e.g. {\\",
+                        "name": "mapping",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV field delimiter, comma by default",
+                        "name": "delimiter",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the file without importing it",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Import rows repeating a recent open call as well",
+                        "name": "force",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import report",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/search": {
+            "get": {
+                "description": "Searches calls by words in the description and client name (russian morphology) and by part of the phone number",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Search user calls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ranked search results",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.CallSearchResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/stats": {
+            "get": {
+                "description": "Returns the number of open calls and of calls in each status, the numbers of calls created and closed on each day from from to to (UTC, 30 days up to today by default, at most 366 days) and the average and percentile time to close the calls closed in that period. Operators get the statistics of the calls visible to them, supervisors of the whole active organization, which they must have",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Get call statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Team statistics without an active organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/stream": {
+            "get": {
+                "description": "Pushes an event whenever a call visible to the authenticated user is created, changed or deleted, by any instance of the service. The event name is the type of the change: created for a call that came into view, updated, or deleted for a call that left it; the data is the change with the call after it, and the event ID is the ID of the change. A comment is sent every 15 seconds to keep the connection open. Changes made while the client is disconnected are not replayed, so it should reload the calls after reconnecting. Browsers may pass the token in the access_token query parameter, since EventSource cannot set headers",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Stream call changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT, instead of the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of call changes",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallStreamEvent"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/trash": {
+            "get": {
+                "description": "Retrieves a page of deleted calls created by or assigned to the authenticated user. Accepts the same parameters as GET /calls",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Get deleted calls",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of phone number",
+                        "name": "phone_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by part of client name",
+                        "name": "client_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag name (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "client_name",
+                            "status",
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of deleted calls",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/ws": {
+            "get": {
+                "description": "Upgrades the connection to a WebSocket and sends a JSON text message whenever a call visible to the authenticated user is created, changed or deleted, with the same content as the events of GET /calls/stream. The server pings the client every 15 seconds and closes the socket if it does not answer; messages from the client are ignored. Browsers may pass the token in the access_token query parameter; pages of other hosts may only open the socket from an allowed origin",
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Call changes over WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT, instead of the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Messages with call changes",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallStreamEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Not a WebSocket handshake",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Origin not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}": {
+            "get": {
+                "description": "Retrieves details of a specific call belonging to the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Get user call by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call details",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid call ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Moves a call belonging to the authenticated user to the trash. It can be restored until the retention period expires",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Delete call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid call ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes client name, phone number and/or description of a call. The If-Match header must carry the ETag returned by GET /calls/{id}, or * to update whatever the version; weak ETags never match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Update call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the call version being edited, or *",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.UpdateCallDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated call",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "412": {
+                        "description": "Call was modified by another request or the ETag is weak",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/assign": {
+            "post": {
+                "description": "Assigns a call to an operator. The call becomes visible to the assignee, who must exist in the auth service and, for a call of an organization, be a member of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Assign call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignee",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.AssignCallDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Assigned call",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Assignee not found or not a member of the organization of the call",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/attachments": {
+            "get": {
+                "description": "Returns the attachments of a call visible to the authenticated user in upload order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Get attachments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Attachment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Uploads a file and attaches it to a call visible to the authenticated user. The file type is detected from its content and must be one of the allowed types: images, PDF and Word documents, plain text and audio recordings by default",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Add attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created attachment",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Attachment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported file type",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/attachments/{attachmentID}": {
+            "get": {
+                "description": "Returns the file of an attachment of a call visible to the authenticated user",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachmentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an attachment together with its file. Only the user who uploaded it may delete an attachment",
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachmentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted"
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Attachment belongs to another user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/callback": {
+            "put": {
+                "description": "Schedules a callback of a call visible to the authenticated user, replacing the previous one. The time is given either with an offset in RFC 3339 format or as a local time (YYYY-MM-DDTHH:MM[:SS]) in the IANA time zone timezone. The callback is kept and returned in that time zone, the default time zone of the service unless set. A reminder is logged when the callback is due",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "callbacks"
+                ],
+                "summary": "Schedule callback",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Callback time",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.ScheduleCallbackDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call with the callback",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the scheduled callback of a call visible to the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "callbacks"
+                ],
+                "summary": "Cancel callback",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call without the callback",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Call has no scheduled callback",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/callback/snooze": {
+            "post": {
+                "description": "Postpones the callback of a call by the given number of minutes, at most a week, counted from the callback time or from now if it is overdue. The reminder is sent again when the new time comes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "callbacks"
+                ],
+                "summary": "Snooze callback",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Delay",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.SnoozeCallbackDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call with the postponed callback",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Call has no scheduled callback",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/comments": {
+            "get": {
+                "description": "Returns comments of a call belonging to the authenticated user in chronological order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Get comments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only internal notes (true) or only client-visible comments (false)",
+                        "name": "internal",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Comment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a comment to a call belonging to the authenticated user. Internal comments are operator notes hidden from the client",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Add comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.CommentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created comment",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/comments/{commentID}": {
+            "delete": {
+                "description": "Deletes a comment. Only the author may delete a comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Delete comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Comment belongs to another user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes the text and/or the internal flag of a comment. Only the author may edit a comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Update comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.UpdateCommentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated comment",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Comment belongs to another user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/history": {
+            "get": {
+                "description": "Returns every field-level change of a call (creation, edits, status changes, assignment, deletion and restore) in chronological order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Get call history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call events",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.CallEvent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid call ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/merge": {
+            "post": {
+                "description": "Moves the history, comments, attachments and tags of duplicate calls to the call and moves the duplicates to the trash, from which they cannot be restored. All calls must be active and visible to the authenticated user, and the duplicates must have the number, the client and the organization of the call",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Merge duplicate calls",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Surviving call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Duplicate calls (up to 20)",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.MergeCallsDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Surviving call",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or duplicate not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Duplicate belongs to a different organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Duplicate has a different number or client",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/restore": {
+            "post": {
+                "description": "Restores a deleted call belonging to the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Restore call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored call",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid call ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found in trash",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/status": {
+            "patch": {
+                "description": "Moves a call to another status of the workflow: new → in_progress → on_hold → resolved → closed, plus reopened",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Update call status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.UpdateCallStatusDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid input or status value",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition is not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.TransitionResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/tags": {
+            "post": {
+                "description": "Attaches a tag from the catalogue of the authenticated user to a call. Attaching an already attached tag changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Attach tag to call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.AttachTagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call with its tags",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call or tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/tags/{tagID}": {
+            "delete": {
+                "description": "Detaches a tag from a call",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Detach tag from call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Call with its tags",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or tag not attached",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/calls/{id}/unassign": {
+            "post": {
+                "description": "Removes the assignee of a call, leaving it visible to its creator and the members of its organization",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "calls"
+                ],
+                "summary": "Unassign call",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Call ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unassigned call",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New call version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Call not found or does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients": {
+            "get": {
+                "description": "Retrieves a page of clients that have active calls created by or assigned to the authenticated user, newest first. Clients are identified by phone number",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Get clients",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of the client name or phone number",
+                        "name": "q",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of clients",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ClientsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients/{id}": {
+            "get": {
+                "description": "Retrieves a client with the number of its active calls visible to the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Get client",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Client",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Client"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid client ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Client not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients/{id}/calls": {
+            "get": {
+                "description": "Retrieves a page of active calls of a client created by or assigned to the authenticated user. Accepts the same parameters as GET /calls",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Get client calls",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-100, default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag name (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "me"
+                        ],
+                        "type": "string",
+                        "description": "Only calls assigned to the authenticated user",
+                        "name": "assigned_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "low",
+                            "normal",
+                            "high",
+                            "critical"
+                        ],
+                        "type": "string",
+                        "description": "Filter by priority",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ok",
+                            "at_risk",
+                            "breached"
+                        ],
+                        "type": "string",
+                        "description": "Filter by SLA state",
+                        "name": "sla",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "client_name",
+                            "status",
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of calls",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CallsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Client not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "get": {
+                "description": "Upgrades the connection to a WebSocket speaking the graphql-transport-ws subprotocol, which the client must request. The client sends connection_init within 10 seconds, then subscribe messages with the same payload as POST /graphql; each result is sent in a next message, and complete ends the operation. The callChanged subscription ends when the client falls behind or the service stops, after which the client should reload the calls. The server pings the client every 15 seconds and closes the socket if it does not answer. Browsers may pass the token in the access_token query parameter",
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL over WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "JWT, instead of the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "graphql-transport-ws messages",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Not a WebSocket handshake",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Executes an operation of the GraphQL schema of the calls, served at rest-service/internal/controller/schema.graphql: the current user, calls with their comments and client, creating calls, changing their status and deleting them. Errors of the operation are returned in the errors field with status 200, with their code in extensions.code: UNAUTHENTICATED, BAD_USER_INPUT, NOT_FOUND, CONFLICT or INTERNAL. Subscriptions are served over the WebSocket of GET /graphql",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL queries and mutations",
+                "parameters": [
+                    {
+                        "description": "GraphQL operation",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data and errors of the operation",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations": {
+            "get": {
+                "description": "Retrieves the invitations to organizations the authenticated user has not accepted or declined yet, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Get invitations",
+                "responses": {
+                    "200": {
+                        "description": "Invitations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Invitation"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations/{id}": {
+            "delete": {
+                "description": "Declines an invitation of the authenticated user, or revokes one sent by an organization the user owns",
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Delete invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid invitation ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations/{id}/accept": {
+            "post": {
+                "description": "Accepts an invitation of the authenticated user and returns the organization joined. It becomes active after POST /auth/switch-org",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Accept invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Joined organization",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Organization"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid invitation ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Invitation not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticates a user and returns a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.AuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid username or password",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs": {
+            "get": {
+                "description": "Retrieves the organizations the authenticated user is a member of ordered by name, with the role of the user and whether the organization is active",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Get organizations",
+                "responses": {
+                    "200": {
+                        "description": "Organizations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Organization"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates an organization with the authenticated user as its owner. The calls of an organization are shared by its members; it becomes active for the owner after POST /auth/switch-org",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Create organization",
+                "parameters": [
+                    {
+                        "description": "Organization",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.OrganizationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created organization",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Organization"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{id}/invitations": {
+            "post": {
+                "description": "Invites a registered user to an organization owned by the authenticated user. The user joins it with POST /invitations/{id}/accept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Invite to organization",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invitee",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.InvitationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created invitation",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Invitation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or invitee not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "User is already a member or invited",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{id}/members": {
+            "get": {
+                "description": "Retrieves the members of an organization of the authenticated user in the order they joined",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Get organization members",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Members",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Member"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid organization ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orgs/{id}/members/{userID}": {
+            "delete": {
+                "description": "Removes a member from an organization. The owner may remove any other member, and a member may leave by removing themselves; the owner cannot leave. The member stops seeing the calls of the organization at once, except those they created or are assigned; the calls they created in the organization stay visible to its members",
+                "tags": [
+                    "orgs"
+                ],
+                "summary": "Remove organization member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Organization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Member user ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Organization or member not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Owner cannot leave the organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Registers a new user with username and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register user",
+                "parameters": [
+                    {
+                        "description": "User registration data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.AuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Retrieves the tag catalogue of the authenticated user ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get tags",
+                "responses": {
+                    "200": {
+                        "description": "Tags",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Tag"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a tag to the catalogue of the authenticated user. Tag names are unique regardless of case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Create tag",
+                "parameters": [
+                    {
+                        "description": "Tag",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created tag",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "delete": {
+                "description": "Deletes a tag of the authenticated user and detaches it from every call",
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid tag ID",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Renames a tag of the authenticated user. The new name is shown on every call the tag is attached to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Rename tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.TagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Renamed tag",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "Retrieves the webhooks of the authenticated user without their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhooks",
+                "responses": {
+                    "200": {
+                        "description": "Webhooks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Webhook"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribes a URL to call events: call.created, call.updated, call.status_changed, call.deleted, call.restored and call.sla_changed, all of them if events is empty. The webhook receives the events of the calls the authenticated user created or is assigned and of the organizations they are a member of. With team set it also receives those of every call of the active organization while the user stays a member of it; only supervisors with an active organization may do this. The URL must resolve to public addresses only. Every delivery is signed with the returned secret, which is not shown again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created webhook with its secret",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Team webhooks are only for supervisors of an active organization",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "delete": {
+                "description": "Deletes a webhook together with its delivery log; pending deliveries are not sent",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted"
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Returns the deliveries of a webhook of the authenticated user, the latest first, with the number of attempts and the outcome of the last one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Delivery state",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of deliveries, 50 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryID}/redeliver": {
+            "post": {
+                "description": "Queues a new delivery with the event and payload of a logged delivery of a webhook of the authenticated user. The new delivery is sent with a fresh signature and retried like any other",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "deliveryID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Queued delivery",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook or delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/apierrors.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apierrors.DuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "call_id": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                }
+            }
+        },
+        "apierrors.Response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "apierrors.TransitionResponse": {
+            "type": "object",
+            "properties": {
+                "allowed_statuses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "current_status": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.AssignCallDTO": {
+            "type": "object",
+            "required": [
+                "assignee_id"
+            ],
+            "properties": {
+                "assignee_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "entity.AttachTagDTO": {
+            "type": "object",
+            "required": [
+                "tag_id"
+            ],
+            "properties": {
+                "tag_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "entity.Attachment": {
+            "type": "object",
+            "properties": {
+                "call_id": {
+                    "type": "integer"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.AuthRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BulkCreateCallsDTO": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "force": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.CallDTO"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "atomic",
+                        "best_effort"
+                    ]
+                }
+            }
+        },
+        "entity.BulkDeleteDTO": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "atomic",
+                        "best_effort"
+                    ]
+                }
+            }
+        },
+        "entity.BulkItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BulkResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.BulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.BulkStatusItemDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.BulkUpdateStatusDTO": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.BulkStatusItemDTO"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "atomic",
+                        "best_effort"
+                    ]
+                }
+            }
+        },
+        "entity.CallDTO": {
+            "type": "object",
+            "required": [
+                "client_name",
+                "description",
                 "phone_number"
             ],
             "properties": {
-                "client_name": {
+                "client_name": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "normal",
+                        "high",
+                        "critical"
+                    ]
+                }
+            }
+        },
+        "entity.CallEvent": {
+            "type": "object",
+            "properties": {
+                "call_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CallHighlight": {
+            "type": "object",
+            "properties": {
+                "client_name": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CallResponse": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "integer"
+                },
+                "callback_at": {
+                    "description": "CallbackAt is given in CallbackTimezone.",
+                    "type": "string"
+                },
+                "callback_timezone": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "client_name": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "phone_e164": {
+                    "type": "string"
+                },
+                "phone_national": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "sla_status": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_label": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CallSearchResult": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "integer"
+                },
+                "callback_at": {
+                    "description": "CallbackAt is given in CallbackTimezone.",
+                    "type": "string"
+                },
+                "callback_timezone": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "client_name": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "highlight": {
+                    "$ref": "#/definitions/entity.CallHighlight"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "phone_e164": {
+                    "type": "string"
+                },
+                "phone_national": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "sla_status": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_label": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.CallStats": {
+            "type": "object",
+            "properties": {
+                "by_status": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatusCount"
+                    }
+                },
+                "daily": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DailyCount"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "open": {
+                    "type": "integer"
+                },
+                "resolution": {
+                    "$ref": "#/definitions/entity.ResolutionStats"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CallStreamEvent": {
+            "type": "object",
+            "properties": {
+                "call": {
+                    "$ref": "#/definitions/entity.CallResponse"
+                },
+                "call_id": {
+                    "type": "integer"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CallsListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CallResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Client": {
+            "type": "object",
+            "properties": {
+                "calls_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_call_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone_national": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ClientsListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Client"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Comment": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "call_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edit_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_internal": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.CommentDTO": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "description": {
+                "is_internal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.DailyCount": {
+            "type": "object",
+            "properties": {
+                "closed": {
+                    "type": "integer"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
                     "type": "string"
                 },
-                "phone_number": {
+                "query": {
                     "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
                 }
             }
         },
-        "entity.CallResponse": {
+        "entity.ImportReport": {
             "type": "object",
             "properties": {
-                "client_name": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "duplicates": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string"
+                },
+                "error": {
                     "type": "string"
                 },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Invitation": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
+                    "type": "integer"
+                },
+                "invited_by": {
+                    "type": "integer"
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "org_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.InvitationDTO": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "entity.Member": {
+            "type": "object",
+            "properties": {
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.MergeCallsDTO": {
+            "type": "object",
+            "required": [
+                "duplicate_ids"
+            ],
+            "properties": {
+                "duplicate_ids": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entity.Organization": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "phone_number": {
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.OrganizationDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "entity.ResolutionStats": {
+            "type": "object",
+            "properties": {
+                "avg_seconds": {
+                    "type": "number"
+                },
+                "closed": {
+                    "type": "integer"
+                },
+                "p50_seconds": {
+                    "type": "number"
+                },
+                "p90_seconds": {
+                    "type": "number"
+                },
+                "p95_seconds": {
+                    "type": "number"
+                }
+            }
+        },
+        "entity.ScheduleCallbackDTO": {
+            "type": "object",
+            "required": [
+                "at"
+            ],
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SnoozeCallbackDTO": {
+            "type": "object",
+            "required": [
+                "minutes"
+            ],
+            "properties": {
+                "minutes": {
+                    "type": "integer",
+                    "maximum": 10080,
+                    "minimum": 1
+                }
+            }
+        },
+        "entity.StatusCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
                     "type": "string"
                 },
                 "status": {
@@ -435,6 +4662,70 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.SwitchOrgDTO": {
+            "type": "object",
+            "properties": {
+                "org_id": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "entity.Tag": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TagDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "entity.UpdateCallDTO": {
+            "type": "object",
+            "properties": {
+                "client_name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "phone_number": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "normal",
+                        "high",
+                        "critical"
+                    ]
+                }
+            }
+        },
         "entity.UpdateCallStatusDTO": {
             "type": "object",
             "required": [
@@ -445,6 +4736,113 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "entity.UpdateCommentDTO": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "is_internal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.Webhook": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "team": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.WebhookDTO": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "maxItems": 6,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "team": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                }
+            }
+        },
+        "entity.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "redelivery_of": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_calls_user_status";
+DROP INDEX IF EXISTS "idx_calls_user_created_at";
\ No newline at end of file
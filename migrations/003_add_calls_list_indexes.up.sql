@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS "idx_calls_user_created_at" ON "calls" ("user_id", "created_at" DESC, "id" DESC);
+CREATE INDEX IF NOT EXISTS "idx_calls_user_status" ON "calls" ("user_id", "status");
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_calls_phone_number_trgm";
+DROP INDEX IF EXISTS "idx_calls_client_name_trgm";
+DROP INDEX IF EXISTS "idx_calls_search_vector";
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "search_vector";
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE "calls" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce("client_name", '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce("description", '')), 'B')
+) STORED;
+
+CREATE INDEX "idx_calls_search_vector" ON "calls" USING GIN ("search_vector");
+CREATE INDEX "idx_calls_client_name_trgm" ON "calls" USING GIN ("client_name" gin_trgm_ops);
+CREATE INDEX "idx_calls_phone_number_trgm" ON "calls" USING GIN ("phone_number" gin_trgm_ops);
\ No newline at end of file
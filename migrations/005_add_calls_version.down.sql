@@ -0,0 +1,3 @@
+ALTER TABLE "calls"
+    DROP COLUMN IF EXISTS "updated_at",
+    DROP COLUMN IF EXISTS "version";
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "calls"
+    ADD COLUMN "version" BIGINT NOT NULL DEFAULT 1,
+    ADD COLUMN "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE "calls" SET "updated_at" = "created_at";
\ No newline at end of file
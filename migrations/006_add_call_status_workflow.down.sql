@@ -0,0 +1,13 @@
+ALTER TABLE "calls" DROP CONSTRAINT IF EXISTS "fk_call_status";
+
+UPDATE "calls" SET "status" = CASE
+    WHEN "status" IN ('resolved', 'closed') THEN 'закрыта'
+    ELSE 'открыта'
+END;
+
+ALTER TABLE "calls"
+    ALTER COLUMN "status" DROP NOT NULL,
+    ALTER COLUMN "status" SET DEFAULT 'открыта',
+    ADD CONSTRAINT "calls_status_check" CHECK (status IN ('открыта', 'закрыта'));
+
+DROP TABLE IF EXISTS "call_statuses";
\ No newline at end of file
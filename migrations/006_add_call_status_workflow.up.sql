@@ -0,0 +1,26 @@
+CREATE TABLE "call_statuses" (
+    "code" TEXT PRIMARY KEY,
+    "label" TEXT NOT NULL,
+    "position" INT NOT NULL
+);
+
+INSERT INTO "call_statuses" ("code", "label", "position") VALUES
+    ('new', 'Новая', 1),
+    ('in_progress', 'В работе', 2),
+    ('on_hold', 'Отложена', 3),
+    ('resolved', 'Решена', 4),
+    ('closed', 'Закрыта', 5),
+    ('reopened', 'Переоткрыта', 6);
+
+ALTER TABLE "calls" DROP CONSTRAINT IF EXISTS "calls_status_check";
+
+UPDATE "calls" SET "status" = CASE COALESCE("status", 'открыта')
+    WHEN 'открыта' THEN 'new'
+    WHEN 'закрыта' THEN 'closed'
+    ELSE "status"
+END;
+
+ALTER TABLE "calls"
+    ALTER COLUMN "status" SET DEFAULT 'new',
+    ALTER COLUMN "status" SET NOT NULL,
+    ADD CONSTRAINT "fk_call_status" FOREIGN KEY ("status") REFERENCES "call_statuses" ("code");
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "call_events";
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE "call_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "call_id" BIGINT NOT NULL,
+    "user_id" BIGINT,
+    "event_type" TEXT NOT NULL,
+    "field" TEXT,
+    "old_value" TEXT,
+    "new_value" TEXT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_call_event_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX "idx_call_events_call_id" ON "call_events" ("call_id", "id");
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "call_comments";
\ No newline at end of file
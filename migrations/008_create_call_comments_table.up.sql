@@ -0,0 +1,14 @@
+CREATE TABLE "call_comments" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "call_id" BIGINT NOT NULL,
+    "author_id" BIGINT NOT NULL,
+    "body" TEXT NOT NULL,
+    "is_internal" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    "edit_count" INT NOT NULL DEFAULT 0,
+    CONSTRAINT fk_comment_call FOREIGN KEY (call_id) REFERENCES calls(id) ON DELETE CASCADE,
+    CONSTRAINT fk_comment_author FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_call_comments_call_id" ON "call_comments" ("call_id", "id");
\ No newline at end of file
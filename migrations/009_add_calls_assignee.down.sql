@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_calls_assignee_id";
+
+ALTER TABLE "calls"
+    DROP CONSTRAINT IF EXISTS fk_call_assignee,
+    DROP COLUMN IF EXISTS "assignee_id";
\ No newline at end of file
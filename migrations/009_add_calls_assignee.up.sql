@@ -0,0 +1,5 @@
+ALTER TABLE "calls"
+    ADD COLUMN "assignee_id" BIGINT,
+    ADD CONSTRAINT fk_call_assignee FOREIGN KEY (assignee_id) REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX "idx_calls_assignee_id" ON "calls" ("assignee_id", "created_at" DESC, "id" DESC);
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS "idx_calls_user_id_sla_status";
+DROP INDEX IF EXISTS "idx_calls_due_at";
+
+ALTER TABLE "calls"
+    DROP CONSTRAINT IF EXISTS calls_sla_status_check,
+    DROP COLUMN IF EXISTS "sla_status",
+    DROP COLUMN IF EXISTS "due_at",
+    DROP COLUMN IF EXISTS "priority";
+
+DROP TYPE IF EXISTS "call_priority";
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TYPE "call_priority" AS ENUM ('low', 'normal', 'high', 'critical');
+
+ALTER TABLE "calls"
+    ADD COLUMN "priority" "call_priority" NOT NULL DEFAULT 'normal',
+    ADD COLUMN "due_at" TIMESTAMPTZ,
+    ADD COLUMN "sla_status" TEXT NOT NULL DEFAULT 'ok',
+    ADD CONSTRAINT calls_sla_status_check CHECK (sla_status IN ('ok', 'at_risk', 'breached'));
+
+-- Open calls get the deadline of the default SLA for their priority, so that
+-- the breach worker checks them as well.
+UPDATE "calls" SET "due_at" = "created_at" + CASE "priority"
+        WHEN 'low' THEN INTERVAL '72 hours'
+        WHEN 'normal' THEN INTERVAL '24 hours'
+        WHEN 'high' THEN INTERVAL '8 hours'
+        WHEN 'critical' THEN INTERVAL '2 hours'
+    END
+WHERE "status" NOT IN ('resolved', 'closed');
+
+CREATE INDEX "idx_calls_due_at" ON "calls" ("due_at") WHERE "due_at" IS NOT NULL AND "status" NOT IN ('resolved', 'closed');
+CREATE INDEX "idx_calls_user_id_sla_status" ON "calls" ("user_id", "sla_status");
\ No newline at end of file
@@ -0,0 +1,5 @@
+DELETE FROM "calls" WHERE "deleted_at" IS NOT NULL;
+
+DROP INDEX IF EXISTS "idx_calls_deleted_at";
+
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "deleted_at";
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "calls" ADD COLUMN "deleted_at" TIMESTAMP;
+
+CREATE INDEX "idx_calls_deleted_at" ON "calls" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
\ No newline at end of file
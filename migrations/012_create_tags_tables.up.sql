@@ -0,0 +1,20 @@
+CREATE TABLE "tags" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" BIGINT NOT NULL,
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_tag_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX "idx_tags_user_name" ON "tags" ("user_id", lower("name"));
+
+CREATE TABLE "call_tags" (
+    "call_id" BIGINT NOT NULL,
+    "tag_id" BIGINT NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("call_id", "tag_id"),
+    CONSTRAINT fk_call_tag_call FOREIGN KEY (call_id) REFERENCES calls(id) ON DELETE CASCADE,
+    CONSTRAINT fk_call_tag_tag FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_call_tags_tag_id" ON "call_tags" ("tag_id", "call_id");
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "client_id";
+
+DROP TABLE IF EXISTS "clients";
\ No newline at end of file
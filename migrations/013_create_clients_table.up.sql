@@ -0,0 +1,28 @@
+CREATE TABLE "clients" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "phone" TEXT NOT NULL UNIQUE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE "calls"
+    ADD COLUMN "client_id" BIGINT,
+    ADD CONSTRAINT fk_call_client FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE SET NULL;
+
+CREATE INDEX "idx_calls_client_id" ON "calls" ("client_id");
+
+-- One client per phone digits. The client has no name of its own: the calls
+-- of different users may name it differently, so it is named after the calls
+-- visible to whoever reads it.
+INSERT INTO "clients" ("phone", "created_at")
+SELECT "phone", min("created_at")
+FROM (
+    SELECT regexp_replace("phone_number", '\D', '', 'g') AS "phone", "created_at"
+    FROM "calls"
+    WHERE "phone_number" IS NOT NULL
+) AS "numbers"
+WHERE "phone" <> ''
+GROUP BY "phone";
+
+UPDATE "calls" SET "client_id" = "clients"."id"
+FROM "clients"
+WHERE "clients"."phone" = regexp_replace("calls"."phone_number", '\D', '', 'g');
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE "clients" DROP CONSTRAINT "clients_phone_key";
+
+UPDATE "clients" SET "phone" = regexp_replace("phone", '\D', '', 'g');
+
+ALTER TABLE "clients" ADD CONSTRAINT "clients_phone_key" UNIQUE ("phone");
+
+DROP INDEX IF EXISTS "idx_calls_phone_e164";
+
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "phone_e164";
+
+ALTER TABLE "calls" ADD CONSTRAINT "calls_phone_number_check" CHECK (phone_number ~ '^(\+?\d{1,3}|\d)?[\d\-]{7,15}$') NOT VALID;
\ No newline at end of file
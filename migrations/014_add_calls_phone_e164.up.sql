@@ -0,0 +1,34 @@
+ALTER TABLE "calls" DROP CONSTRAINT IF EXISTS "calls_phone_number_check";
+
+ALTER TABLE "calls" ADD COLUMN "phone_e164" TEXT;
+
+-- Existing numbers are read in the default region RU; numbers that do not fit are left without E.164.
+UPDATE "calls" SET "phone_e164" = CASE
+    WHEN "phone_number" ~ '^\s*\+' AND "digits" ~ '^[1-9]\d{7,14}$' THEN '+' || "digits"
+    WHEN "digits" ~ '^[78]\d{10}$' THEN '+7' || right("digits", 10)
+    WHEN "digits" ~ '^\d{10}$' THEN '+7' || "digits"
+END
+FROM (SELECT "id", regexp_replace("phone_number", '\D', '', 'g') AS "digits" FROM "calls") AS "numbers"
+WHERE "numbers"."id" = "calls"."id";
+
+ALTER TABLE "calls" ADD CONSTRAINT "calls_phone_e164_check" CHECK ("phone_e164" ~ '^\+[1-9]\d{7,14}$');
+
+CREATE INDEX "idx_calls_phone_e164" ON "calls" ("phone_e164");
+
+-- Clients are now keyed by E.164, which merges clients whose numbers were written differently.
+ALTER TABLE "clients" DROP CONSTRAINT "clients_phone_key";
+
+UPDATE "clients" SET "phone" = "numbers"."phone"
+FROM (SELECT "client_id", min("phone_e164") AS "phone" FROM "calls" WHERE "phone_e164" IS NOT NULL GROUP BY "client_id") AS "numbers"
+WHERE "numbers"."client_id" = "clients"."id";
+
+DELETE FROM "clients" WHERE "phone" !~ '^\+';
+
+UPDATE "calls" SET "client_id" = "merged"."keep"
+FROM (SELECT "id", min("id") OVER (PARTITION BY "phone") AS "keep" FROM "clients") AS "merged"
+WHERE "merged"."id" = "calls"."client_id" AND "merged"."keep" <> "merged"."id";
+
+DELETE FROM "clients" USING "clients" AS "kept"
+WHERE "kept"."phone" = "clients"."phone" AND "kept"."id" < "clients"."id";
+
+ALTER TABLE "clients" ADD CONSTRAINT "clients_phone_key" UNIQUE ("phone");
\ No newline at end of file
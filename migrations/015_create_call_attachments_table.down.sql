@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "call_attachments";
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE "call_attachments" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "call_id" BIGINT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "file_name" TEXT NOT NULL,
+    "content_type" TEXT NOT NULL,
+    "size" BIGINT NOT NULL CHECK ("size" >= 0),
+    "storage_key" TEXT NOT NULL UNIQUE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_attachment_call FOREIGN KEY (call_id) REFERENCES calls(id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachment_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_call_attachments_call_id" ON "call_attachments" ("call_id", "id");
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "role";
+
+DROP INDEX IF EXISTS "idx_calls_closed_at";
+
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "closed_at";
\ No newline at end of file
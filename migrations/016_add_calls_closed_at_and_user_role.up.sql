@@ -0,0 +1,13 @@
+ALTER TABLE "calls" ADD COLUMN "closed_at" TIMESTAMP;
+
+UPDATE "calls" c SET "closed_at" = COALESCE(
+    (SELECT max(e."created_at") FROM "call_events" e
+     WHERE e."call_id" = c."id" AND e."event_type" = 'status_changed' AND e."new_value" = 'closed'),
+    c."updated_at"
+)
+WHERE c."status" = 'closed';
+
+CREATE INDEX "idx_calls_closed_at" ON "calls" ("closed_at") WHERE "closed_at" IS NOT NULL;
+
+ALTER TABLE "users" ADD COLUMN "role" TEXT NOT NULL DEFAULT 'operator'
+    CONSTRAINT "users_role_check" CHECK ("role" IN ('operator', 'supervisor'));
\ No newline at end of file
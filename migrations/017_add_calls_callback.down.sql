@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "idx_calls_callback_at";
+
+ALTER TABLE "calls"
+    DROP CONSTRAINT IF EXISTS "calls_callback_timezone_check",
+    DROP COLUMN IF EXISTS "callback_notified_at",
+    DROP COLUMN IF EXISTS "callback_timezone",
+    DROP COLUMN IF EXISTS "callback_at";
\ No newline at end of file
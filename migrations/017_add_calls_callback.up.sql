@@ -0,0 +1,7 @@
+ALTER TABLE "calls"
+    ADD COLUMN "callback_at" TIMESTAMPTZ,
+    ADD COLUMN "callback_timezone" TEXT,
+    ADD COLUMN "callback_notified_at" TIMESTAMPTZ,
+    ADD CONSTRAINT "calls_callback_timezone_check" CHECK (("callback_at" IS NULL) = ("callback_timezone" IS NULL));
+
+CREATE INDEX "idx_calls_callback_at" ON "calls" ("callback_at") WHERE "callback_at" IS NOT NULL AND "deleted_at" IS NULL;
\ No newline at end of file
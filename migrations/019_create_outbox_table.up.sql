@@ -0,0 +1,7 @@
+CREATE TABLE "outbox" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "call_id" BIGINT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "outbox_notify" ON "outbox";
+
+DROP FUNCTION IF EXISTS "notify_call_change"();
\ No newline at end of file
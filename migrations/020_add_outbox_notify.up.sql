@@ -0,0 +1,27 @@
+-- Announces every change put into the outbox to the listening instances of
+-- the service, with the users who could see the call before or after it.
+CREATE FUNCTION "notify_call_change"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('call_changes', json_build_object(
+        'id', NEW.id,
+        'call_id', NEW.call_id,
+        'event', NEW.event_type,
+        'viewers', ARRAY(
+            SELECT DISTINCT viewer FROM (
+                SELECT user_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT assignee_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT (c ->> 'old_value')::BIGINT
+                FROM jsonb_array_elements(NEW.payload -> 'changes') AS c
+                WHERE c ->> 'field' = 'assignee_id'
+            ) AS v(viewer)
+            WHERE viewer IS NOT NULL
+        )
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "outbox_notify" AFTER INSERT ON "outbox"
+    FOR EACH ROW EXECUTE FUNCTION "notify_call_change"();
\ No newline at end of file
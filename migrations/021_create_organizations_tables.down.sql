@@ -0,0 +1,36 @@
+CREATE OR REPLACE FUNCTION "notify_call_change"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('call_changes', json_build_object(
+        'id', NEW.id,
+        'call_id', NEW.call_id,
+        'event', NEW.event_type,
+        'viewers', ARRAY(
+            SELECT DISTINCT viewer FROM (
+                SELECT user_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT assignee_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT (c ->> 'old_value')::BIGINT
+                FROM jsonb_array_elements(NEW.payload -> 'changes') AS c
+                WHERE c ->> 'field' = 'assignee_id'
+            ) AS v(viewer)
+            WHERE viewer IS NOT NULL
+        )
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS "idx_calls_org_id";
+
+ALTER TABLE "webhooks" DROP COLUMN IF EXISTS "org_id";
+
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "org_id";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "org_id";
+
+DROP TABLE IF EXISTS "invitations";
+
+DROP TABLE IF EXISTS "memberships";
+
+DROP TABLE IF EXISTS "organizations";
\ No newline at end of file
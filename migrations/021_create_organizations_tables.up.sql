@@ -0,0 +1,78 @@
+CREATE TABLE "organizations" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" TEXT NOT NULL,
+    "created_by" BIGINT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_organization_creator FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE "memberships" (
+    "org_id" BIGINT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "role" TEXT NOT NULL DEFAULT 'member' CHECK ("role" IN ('owner', 'member')),
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("org_id", "user_id"),
+    CONSTRAINT fk_membership_org FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_membership_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX "idx_memberships_user_id" ON "memberships" ("user_id");
+
+CREATE TABLE "invitations" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "org_id" BIGINT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "invited_by" BIGINT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_invitation_org FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitation_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitation_inviter FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT invitations_org_user_key UNIQUE ("org_id", "user_id")
+);
+
+CREATE INDEX "idx_invitations_user_id" ON "invitations" ("user_id", "id");
+
+-- The organization the tokens of a user are issued for at login, the last one
+-- the user switched to. It is always one the user is a member of.
+ALTER TABLE "users" ADD COLUMN "org_id" BIGINT
+    CONSTRAINT fk_user_org REFERENCES organizations(id) ON DELETE SET NULL;
+
+-- The organization active in the session the call was created in. It does not
+-- change afterwards, also when the creator leaves the organization, and calls
+-- created before organizations existed belong to none.
+ALTER TABLE "calls" ADD COLUMN "org_id" BIGINT
+    CONSTRAINT fk_call_org REFERENCES organizations(id) ON DELETE SET NULL;
+
+-- The organization whose calls a team webhook receives. Team webhooks created
+-- before organizations existed have none and receive nothing.
+ALTER TABLE "webhooks" ADD COLUMN "org_id" BIGINT
+    CONSTRAINT fk_webhook_org REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX "idx_calls_org_id" ON "calls" ("org_id", "created_at" DESC, "id" DESC) WHERE "deleted_at" IS NULL;
+
+-- The organization of the call goes along with the direct viewers, and each
+-- instance finds the streams open in it itself: listing its members here
+-- would outgrow the 8000 bytes pg_notify takes and fail the change.
+CREATE OR REPLACE FUNCTION "notify_call_change"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('call_changes', json_build_object(
+        'id', NEW.id,
+        'call_id', NEW.call_id,
+        'event', NEW.event_type,
+        'org_id', (SELECT COALESCE(org_id, 0) FROM calls WHERE id = NEW.call_id),
+        'viewers', ARRAY(
+            SELECT DISTINCT viewer FROM (
+                SELECT user_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT assignee_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT (c ->> 'old_value')::BIGINT
+                FROM jsonb_array_elements(NEW.payload -> 'changes') AS c
+                WHERE c ->> 'field' = 'assignee_id'
+            ) AS v(viewer)
+            WHERE viewer IS NOT NULL
+        )
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
\ No newline at end of file
@@ -0,0 +1,29 @@
+CREATE OR REPLACE FUNCTION "notify_call_change"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('call_changes', json_build_object(
+        'id', NEW.id,
+        'call_id', NEW.call_id,
+        'event', NEW.event_type,
+        'org_id', (SELECT COALESCE(org_id, 0) FROM calls WHERE id = NEW.call_id),
+        'viewers', ARRAY(
+            SELECT DISTINCT viewer FROM (
+                SELECT user_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT assignee_id FROM calls WHERE id = NEW.call_id
+                UNION ALL
+                SELECT (c ->> 'old_value')::BIGINT
+                FROM jsonb_array_elements(NEW.payload -> 'changes') AS c
+                WHERE c ->> 'field' = 'assignee_id'
+            ) AS v(viewer)
+            WHERE viewer IS NOT NULL
+        )
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "outbox_webhooks" ON "outbox";
+
+DROP FUNCTION IF EXISTS "queue_call_webhooks"();
+
+DROP TABLE IF EXISTS "webhook_events";
\ No newline at end of file
@@ -0,0 +1,53 @@
+CREATE TABLE "webhook_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "call_id" BIGINT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "user_id" BIGINT NOT NULL,
+    "owner_id" BIGINT,
+    "assignee_id" BIGINT,
+    "org_id" BIGINT,
+    "occurred_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Keeps every change put into the outbox for the webhooks, in the same
+-- transaction, together with the users who could see the call after it. They
+-- come with the message rather than from calls, so that a call deleted for
+-- good in the same transaction can still be matched to its webhooks.
+CREATE FUNCTION "queue_call_webhooks"() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO webhook_events (call_id, event_type, user_id, owner_id, assignee_id, org_id, occurred_at)
+    SELECT NEW.call_id, NEW.event_type, COALESCE((NEW.payload ->> 'user_id')::BIGINT, 0),
+        (NEW.payload ->> 'owner_id')::BIGINT, (NEW.payload ->> 'assignee_id')::BIGINT,
+        NULLIF((NEW.payload ->> 'org_id')::BIGINT, 0), NEW.created_at
+    WHERE EXISTS (SELECT 1 FROM webhooks);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "outbox_webhooks" AFTER INSERT ON "outbox"
+    FOR EACH ROW EXECUTE FUNCTION "queue_call_webhooks"();
+
+-- Change notifications take the viewers of the call from the message as well.
+CREATE OR REPLACE FUNCTION "notify_call_change"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('call_changes', json_build_object(
+        'id', NEW.id,
+        'call_id', NEW.call_id,
+        'event', NEW.event_type,
+        'org_id', COALESCE((NEW.payload ->> 'org_id')::BIGINT, 0),
+        'viewers', ARRAY(
+            SELECT DISTINCT viewer FROM (
+                SELECT (NEW.payload ->> 'owner_id')::BIGINT
+                UNION ALL
+                SELECT (NEW.payload ->> 'assignee_id')::BIGINT
+                UNION ALL
+                SELECT (c ->> 'old_value')::BIGINT
+                FROM jsonb_array_elements(NEW.payload -> 'changes') AS c
+                WHERE c ->> 'field' = 'assignee_id'
+            ) AS v(viewer)
+            WHERE viewer IS NOT NULL
+        )
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DELETE FROM "calls" WHERE "merged_into" IS NOT NULL;
+
+DROP INDEX IF EXISTS "idx_calls_merged_into";
+
+ALTER TABLE "calls" DROP COLUMN IF EXISTS "merged_into";
\ No newline at end of file
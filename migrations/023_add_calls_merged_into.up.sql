@@ -0,0 +1,3 @@
+ALTER TABLE "calls" ADD COLUMN "merged_into" BIGINT REFERENCES "calls" ("id") ON DELETE CASCADE;
+
+CREATE INDEX "idx_calls_merged_into" ON "calls" ("merged_into") WHERE "merged_into" IS NOT NULL;
\ No newline at end of file
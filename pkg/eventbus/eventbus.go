@@ -0,0 +1,54 @@
+// Package eventbus publishes service events to the log or to NATS JetStream
+// behind a single interface.
+package eventbus
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// Message is an event of type Type about the entity Key, such as a call ID,
+// with a JSON body. ID is unique to the event, so that a consumer can tell a
+// message published again from a new one.
+type Message struct {
+	ID   string
+	Type string
+	Key  string
+	Body []byte
+}
+
+// Publisher sends messages to their consumers. Delivery is at least once: a
+// message whose Publish failed, or whose success was not recorded, is
+// published again with the same ID.
+type Publisher interface {
+	// Publish returns once the message is accepted. Messages published one
+	// after another reach the consumers of a key in the same order.
+	Publish(ctx context.Context, m Message) error
+	// Close releases the connection of the publisher, if there is one.
+	Close() error
+}
+
+// Log writes messages to the log of the service, for development and for
+// deployments without a broker.
+type Log struct {
+	l zerolog.Logger
+}
+
+func NewLog(l zerolog.Logger) *Log {
+	return &Log{l: l}
+}
+
+func (p *Log) Publish(_ context.Context, m Message) error {
+	p.l.Info().
+		Str("id", m.ID).
+		Str("type", m.Type).
+		Str("key", m.Key).
+		RawJSON("body", m.Body).
+		Msg("Event published")
+	return nil
+}
+
+func (p *Log) Close() error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package eventbus_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"calls-service/pkg/eventbus"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLog(t *testing.T) {
+	var buf bytes.Buffer
+	p := eventbus.NewLog(zerolog.New(&buf))
+
+	err := p.Publish(context.Background(), eventbus.Message{ID: "1", Type: "created", Key: "42", Body: []byte(`{"call_id":42}`)})
+	assert.NoError(t, err)
+
+	var line map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "1", line["id"])
+	assert.Equal(t, "created", line["type"])
+	assert.Equal(t, "42", line["key"])
+	assert.Equal(t, map[string]any{"call_id": float64(42)}, line["body"])
+	assert.NoError(t, p.Close())
+}
+
+// TestNATS runs against the server given by EVENTBUS_TEST_NATS_URL, e.g. a
+// local one started with nats-server -js or docker compose --profile nats up nats.
+func TestNATS(t *testing.T) {
+	url := os.Getenv("EVENTBUS_TEST_NATS_URL")
+	if url == "" {
+		t.Skip("EVENTBUS_TEST_NATS_URL is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const stream = "EVENTBUS_TEST"
+	p, err := eventbus.NewNATS(ctx, eventbus.NATSConfig{
+		URL:         url,
+		Stream:      stream,
+		Subject:     "eventbus-test",
+		DedupWindow: time.Minute,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = p.Close() }()
+
+	nc, err := nats.Connect(url)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer nc.Close()
+
+	js, err := jetstream.New(nc)
+	assert.NoError(t, err)
+	defer func() { _ = js.DeleteStream(context.Background(), stream) }()
+
+	// The message published again after a lost acknowledgement is dropped.
+	messages := []eventbus.Message{
+		{ID: "1", Type: "created", Key: "42", Body: []byte(`{"n":1}`)},
+		{ID: "1", Type: "created", Key: "42", Body: []byte(`{"n":1}`)},
+		{ID: "2", Type: "status_changed", Key: "42", Body: []byte(`{"n":2}`)},
+	}
+	for _, m := range messages {
+		assert.NoError(t, p.Publish(ctx, m))
+	}
+
+	cons, err := js.OrderedConsumer(ctx, stream, jetstream.OrderedConsumerConfig{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	batch, err := cons.Fetch(len(messages), jetstream.FetchMaxWait(time.Second))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var subjects, ids []string
+	for msg := range batch.Messages() {
+		subjects = append(subjects, msg.Subject())
+		ids = append(ids, msg.Headers().Get(jetstream.MsgIDHeader))
+	}
+	assert.Equal(t, []string{"eventbus-test.created.42", "eventbus-test.status_changed.42"}, subjects)
+	assert.Equal(t, []string{"1", "2"}, ids)
+}
@@ -0,0 +1,70 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSConfig sets the server and the JetStream stream messages are kept in.
+// A message is published on Subject.<type>.<key>, e.g. calls.created.42.
+// The stream drops a message whose ID it has seen within DedupWindow.
+type NATSConfig struct {
+	URL         string
+	Stream      string
+	Subject     string
+	DedupWindow time.Duration
+}
+
+// NATS publishes messages to a JetStream stream and waits for the stream to
+// store each of them.
+type NATS struct {
+	nc      *nats.Conn
+	js      jetstream.JetStream
+	subject string
+}
+
+// NewNATS connects to the server and creates the stream if it does not exist.
+func NewNATS(ctx context.Context, cfg NATSConfig) (*NATS, error) {
+	nc, err := nats.Connect(cfg.URL, nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       cfg.Stream,
+		Subjects:   []string{cfg.Subject + ".>"},
+		Duplicates: cfg.DedupWindow,
+	})
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("failed to create stream: %w", err)
+	}
+
+	return &NATS{nc: nc, js: js, subject: cfg.Subject}, nil
+}
+
+func (p *NATS) Publish(ctx context.Context, m Message) error {
+	msg := nats.NewMsg(p.subject + "." + m.Type + "." + m.Key)
+	msg.Header.Set(jetstream.MsgIDHeader, m.ID)
+	msg.Data = m.Body
+
+	if _, err := p.js.PublishMsg(ctx, msg); err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+	return nil
+}
+
+// Close waits for pending messages to be sent and closes the connection.
+func (p *NATS) Close() error {
+	return p.nc.Drain()
+}
@@ -16,9 +16,9 @@ type Server struct {
 	notify          chan error
 }
 
-func New(port string) *Server {
+func New(port string, opts ...grpc.ServerOption) *Server {
 	s := &Server{
-		grpcServer:      grpc.NewServer(),
+		grpcServer:      grpc.NewServer(opts...),
 		addr:            ":" + port,
 		shutdownTimeout: 10 * time.Second,
 		notify:          make(chan error, 1),
@@ -57,3 +57,37 @@ func (s *Server) Shutdown() error {
 	defer cancel()
 	return s.server.Shutdown(ctx)
 }
+
+// WriteTimeout overrides the server write timeout for the routes it is used
+// on, e.g. for long downloads. A zero d removes the deadline.
+func WriteTimeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var deadline time.Time
+		if d > 0 {
+			deadline = time.Now().Add(d)
+		}
+
+		if err := http.NewResponseController(c.Writer).SetWriteDeadline(deadline); err != nil {
+			_ = c.Error(err)
+		}
+
+		c.Next()
+	}
+}
+
+// ReadTimeout overrides the server read timeout for the routes it is used on,
+// e.g. for large uploads. A zero d removes the deadline.
+func ReadTimeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var deadline time.Time
+		if d > 0 {
+			deadline = time.Now().Add(d)
+		}
+
+		if err := http.NewResponseController(c.Writer).SetReadDeadline(deadline); err != nil {
+			_ = c.Error(err)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,78 @@
+package httpserver_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"calls-service/pkg/httpserver"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowBody sends n bytes one at a time, pausing between them.
+func slowBody(n int, pause time.Duration) io.Reader {
+	r, w := io.Pipe()
+	go func() {
+		for range n {
+			time.Sleep(pause)
+			if _, err := w.Write([]byte{'x'}); err != nil {
+				return
+			}
+		}
+		_ = w.Close()
+	}()
+	return r
+}
+
+func TestReadTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	upload := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.Status(http.StatusRequestTimeout)
+			return
+		}
+		c.String(http.StatusOK, strconv.Itoa(len(body)))
+	}
+	engine.POST("/default", upload)
+	engine.POST("/upload", httpserver.ReadTimeout(5*time.Second), upload)
+
+	srv := httptest.NewUnstartedServer(engine)
+	srv.Config.ReadTimeout = 100 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		uploaded bool
+	}{
+		{"Server timeout cuts the body off", "/default", false},
+		{"Route timeout lets the body through", "/upload", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(srv.URL+tt.path, "application/octet-stream", slowBody(8, 50*time.Millisecond))
+			if !tt.uploaded {
+				if err == nil {
+					assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+					_ = resp.Body.Close()
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "8", string(body))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Package phone parses phone numbers written in national or international
+// format into E.164 and formats them back for display.
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultRegion is the region national numbers are read in when none is configured.
+const DefaultRegion = "RU"
+
+var (
+	ErrInvalid       = errors.New("invalid phone number")
+	ErrUnknownRegion = errors.New("unknown phone region")
+)
+
+// region describes the numbering plan of a country: its calling code, the
+// trunk prefix dialled before national numbers, the allowed lengths of the
+// national significant number and the display pattern, in which every X
+// stands for a digit of the national number.
+type region struct {
+	code     string
+	trunk    string
+	min, max int
+	pattern  string
+}
+
+var regions = map[string]region{
+	"RU": {code: "7", trunk: "8", min: 10, max: 10, pattern: "8 (XXX) XXX-XX-XX"},
+	"KZ": {code: "7", trunk: "8", min: 10, max: 10, pattern: "8 (XXX) XXX-XX-XX"},
+	"BY": {code: "375", trunk: "80", min: 9, max: 9, pattern: "8 0XX XXX-XX-XX"},
+	"UA": {code: "380", trunk: "0", min: 9, max: 9, pattern: "0XX XXX XX XX"},
+	"UZ": {code: "998", min: 9, max: 9, pattern: "XX XXX-XX-XX"},
+	"KG": {code: "996", trunk: "0", min: 9, max: 9, pattern: "0XXX XXX XXX"},
+	"TJ": {code: "992", min: 9, max: 9, pattern: "XX XXX XXXX"},
+	"AM": {code: "374", trunk: "0", min: 8, max: 8, pattern: "0XX XXXXXX"},
+	"AZ": {code: "994", trunk: "0", min: 9, max: 9, pattern: "0XX XXX XX XX"},
+	"GE": {code: "995", trunk: "0", min: 9, max: 9, pattern: "XXX XX XX XX"},
+	"TR": {code: "90", trunk: "0", min: 10, max: 10, pattern: "0XXX XXX XX XX"},
+	"DE": {code: "49", trunk: "0", min: 6, max: 13},
+	"FR": {code: "33", trunk: "0", min: 9, max: 9, pattern: "0X XX XX XX XX"},
+	"GB": {code: "44", trunk: "0", min: 9, max: 10, pattern: "0XXXX XXXXXX"},
+	"US": {code: "1", trunk: "1", min: 10, max: 10, pattern: "(XXX) XXX-XXXX"},
+	"CN": {code: "86", trunk: "0", min: 10, max: 11, pattern: "XXX XXXX XXXX"},
+}
+
+// byCode picks the region that validates and formats the numbers of each
+// calling code. Regions sharing a code share the numbering plan too.
+var byCode = map[string]string{
+	"7": "RU", "375": "BY", "380": "UA", "998": "UZ", "996": "KG", "992": "TJ", "374": "AM",
+	"994": "AZ", "995": "GE", "90": "TR", "49": "DE", "33": "FR", "44": "GB", "1": "US", "86": "CN",
+}
+
+func (r region) fits(n int) bool {
+	return n >= r.min && n <= r.max
+}
+
+// Number is a phone number split into the country calling code and the
+// national significant number. A number of a country this package does not
+// know keeps all its digits in National and has no Code.
+type Number struct {
+	Code     string
+	National string
+}
+
+// E164 returns the number in E.164 format, e.g. +79876543211.
+func (n Number) E164() string {
+	return "+" + n.Code + n.National
+}
+
+// Format returns the number the way it is written inside its country, e.g.
+// 8 (987) 654-32-11. Numbers of unknown countries are returned in E.164.
+func (n Number) Format() string {
+	r, ok := regions[byCode[n.Code]]
+	if !ok {
+		return n.E164()
+	}
+	if strings.Count(r.pattern, "X") != len(n.National) {
+		return r.trunk + n.National
+	}
+
+	var sb strings.Builder
+	digits := n.National
+	for _, c := range r.pattern {
+		if c == 'X' {
+			sb.WriteByte(digits[0])
+			digits = digits[1:]
+			continue
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// Parser reads numbers without a country code as numbers of its region.
+type Parser struct {
+	region region
+}
+
+// NewParser returns a parser for the region with the given ISO 3166 code.
+func NewParser(regionCode string) (*Parser, error) {
+	r, ok := regions[strings.ToUpper(regionCode)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownRegion, regionCode)
+	}
+	return &Parser{region: r}, nil
+}
+
+// Parse reads a number written with digits, spaces, dashes, dots and
+// parentheses. A number starting with + or 00 is international; any other is
+// read as a national number of the parser region, with or without the trunk
+// prefix, or as an international number of that region without the +.
+func (p *Parser) Parse(raw string) (Number, error) {
+	s := strings.TrimSpace(raw)
+	international := strings.HasPrefix(s, "+")
+	if international {
+		s = s[1:]
+	}
+
+	digits, ok := digitsOf(s)
+	if !ok {
+		return Number{}, ErrInvalid
+	}
+	if !international && strings.HasPrefix(digits, "00") {
+		international, digits = true, digits[2:]
+	}
+	if international {
+		return parseInternational(digits)
+	}
+
+	r := p.region
+	switch {
+	case r.trunk != "" && strings.HasPrefix(digits, r.trunk) && r.fits(len(digits)-len(r.trunk)):
+		return Number{Code: r.code, National: digits[len(r.trunk):]}, nil
+	case r.fits(len(digits)):
+		return Number{Code: r.code, National: digits}, nil
+	case strings.HasPrefix(digits, r.code) && r.fits(len(digits)-len(r.code)):
+		return Number{Code: r.code, National: digits[len(r.code):]}, nil
+	}
+	return Number{}, ErrInvalid
+}
+
+// Parse reads an international number, such as one in E.164 format.
+func Parse(raw string) (Number, error) {
+	s := strings.TrimSpace(raw)
+	if !strings.HasPrefix(s, "+") {
+		return Number{}, ErrInvalid
+	}
+	digits, ok := digitsOf(s[1:])
+	if !ok {
+		return Number{}, ErrInvalid
+	}
+	return parseInternational(digits)
+}
+
+// Format returns the display format of an E.164 number, or the number itself
+// if it cannot be parsed.
+func Format(e164 string) string {
+	n, err := Parse(e164)
+	if err != nil {
+		return e164
+	}
+	return n.Format()
+}
+
+// parseInternational splits the digits after + into the calling code and
+// the national number. Calling codes never prefix one another, so the first
+// known code is the only candidate.
+func parseInternational(digits string) (Number, error) {
+	for l := 1; l <= 3 && l < len(digits); l++ {
+		regionCode, ok := byCode[digits[:l]]
+		if !ok {
+			continue
+		}
+		if !regions[regionCode].fits(len(digits) - l) {
+			return Number{}, ErrInvalid
+		}
+		return Number{Code: digits[:l], National: digits[l:]}, nil
+	}
+
+	// E.164 numbers have at most 15 digits; shorter than 8 is no real number.
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return Number{}, ErrInvalid
+	}
+	return Number{National: digits}, nil
+}
+
+// digitsOf returns the digits of s, which may only be separated by spaces,
+// dashes, dots and parentheses.
+func digitsOf(s string) (string, bool) {
+	var sb strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		case c == ' ', c == '-', c == '.', c == '(', c == ')':
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
@@ -0,0 +1,59 @@
+package phone_test
+
+import (
+	"testing"
+
+	"calls-service/pkg/phone"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		region   string
+		input    string
+		expected string
+		national string
+	}{
+		{"Valid with plus and country code", "RU", "+71234567890", "+71234567890", "8 (123) 456-78-90"},
+		{"Valid without plus", "RU", "81234567890", "+71234567890", "8 (123) 456-78-90"},
+		{"Valid with dashes", "RU", "812-345-6789", "+78123456789", "8 (812) 345-67-89"},
+		{"National with trunk prefix", "RU", "8 (987) 654-32-11", "+79876543211", "8 (987) 654-32-11"},
+		{"International without plus", "RU", "79876543211", "+79876543211", "8 (987) 654-32-11"},
+		{"International with 00", "RU", "00 375 29 123 45 67", "+375291234567", "8 029 123-45-67"},
+		{"Other country", "RU", "+1 (201) 555-0123", "+12015550123", "(201) 555-0123"},
+		{"Unknown country", "RU", "+34 612 345 678", "+34612345678", "+34612345678"},
+		{"Other default region", "BY", "8 029 123-45-67", "+375291234567", "8 029 123-45-67"},
+		{"Too short", "RU", "12345", "", ""},
+		{"Too long", "RU", "12345678901234567890", "", ""},
+		{"Wrong length for country", "RU", "+7987654321", "", ""},
+		{"Letters inside", "RU", "123ABC7890", "", ""},
+		{"Empty string", "RU", "", "", ""},
+		{"Plus only", "RU", "+", "", ""},
+		{"Country code only", "RU", "+7", "", ""},
+		{"Plus inside", "RU", "8+9876543211", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := phone.NewParser(tt.region)
+			assert.NoError(t, err)
+
+			n, err := p.Parse(tt.input)
+			if tt.expected == "" {
+				assert.ErrorIs(t, err, phone.ErrInvalid)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, n.E164())
+			assert.Equal(t, tt.national, n.Format())
+			assert.Equal(t, tt.national, phone.Format(n.E164()))
+		})
+	}
+}
+
+func TestNewParserUnknownRegion(t *testing.T) {
+	_, err := phone.NewParser("XX")
+	assert.ErrorIs(t, err, phone.ErrUnknownRegion)
+}
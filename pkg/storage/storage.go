@@ -0,0 +1,21 @@
+// Package storage keeps files in a local directory or in an S3-compatible
+// bucket behind a single interface.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("file not found")
+
+// Storage stores files under slash-separated keys such as calls/1/3f2a.
+type Storage interface {
+	// Put stores size bytes read from r under key, replacing any file there.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the file stored under key. It returns ErrNotFound if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the file stored under key. A missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
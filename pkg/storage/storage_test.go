@@ -0,0 +1,69 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"calls-service/pkg/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocal(t *testing.T) {
+	s, err := storage.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+
+	testStorage(t, s)
+
+	err = s.Put(context.Background(), "../outside", strings.NewReader("x"), 1, "text/plain")
+	assert.Error(t, err)
+}
+
+// TestS3 runs against the service given by STORAGE_TEST_S3_ENDPOINT, e.g. a
+// local MinIO started with docker compose --profile s3 up minio.
+func TestS3(t *testing.T) {
+	endpoint := os.Getenv("STORAGE_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("STORAGE_TEST_S3_ENDPOINT is not set")
+	}
+
+	s, err := storage.NewS3(context.Background(), storage.S3Config{
+		Endpoint:  endpoint,
+		AccessKey: os.Getenv("STORAGE_TEST_S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("STORAGE_TEST_S3_SECRET_KEY"),
+		Bucket:    "storage-test",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	testStorage(t, s)
+}
+
+func testStorage(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	const key = "calls/1/test"
+
+	_, err := s.Get(ctx, key)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	assert.NoError(t, s.Put(ctx, key, strings.NewReader("hello"), 5, "text/plain"))
+
+	r, err := s.Get(ctx, key)
+	if !assert.NoError(t, err) {
+		return
+	}
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "hello", string(data))
+
+	assert.NoError(t, s.Delete(ctx, key))
+	assert.NoError(t, s.Delete(ctx, key), "deleting a missing file")
+
+	_, err = s.Get(ctx, key)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
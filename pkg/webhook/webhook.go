@@ -0,0 +1,181 @@
+// Package webhook sends signed webhook requests.
+//
+// Every request carries the event type, the delivery ID, the Unix time it was
+// sent at and a signature: "sha256=" followed by the hex HMAC-SHA256 of the
+// time, a dot and the body, keyed with the secret of the subscription.
+//
+// Requests are only sent to public addresses, so that a subscription cannot
+// reach the internal network of the service.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseSize is read from a response so that the connection can be reused.
+const maxResponseSize = 64 << 10
+
+// ErrForbiddenAddress reports a webhook host that is not a public address,
+// such as a loopback, private or link-local one.
+var ErrForbiddenAddress = errors.New("webhook address is not public")
+
+// sharedAddressSpace is the carrier-grade NAT range, which IsPrivate does not cover.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublic reports whether addr may receive webhooks.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// CheckURL resolves the host of rawURL and returns ErrForbiddenAddress if any
+// of its addresses is not public.
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve host: %w", err)
+	}
+	for _, addr := range addrs {
+		if !IsPublic(addr) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// Message is a single delivery of an event to URL.
+type Message struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID int64
+	Body       []byte
+}
+
+// StatusError reports a response with a status other than 2xx.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d", e.StatusCode)
+}
+
+// Sign returns the signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of body sent at timestamp.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+type Client struct {
+	http         *http.Client
+	allowPrivate bool
+}
+
+type Option func(*Client)
+
+// AllowPrivateAddresses lets the client send requests to any address, for
+// receivers on a local network.
+func AllowPrivateAddresses() Option {
+	return func(c *Client) {
+		c.allowPrivate = true
+	}
+}
+
+// NewClient returns a client that gives up on a request after timeout.
+// Redirects are not followed. Unless AllowPrivateAddresses is given, every
+// connection is checked after the host is resolved and refused with
+// ErrForbiddenAddress if it is not to a public address.
+func NewClient(timeout time.Duration, opts ...Option) *Client {
+	c := &Client{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !c.allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !IsPublic(addrPort.Addr()) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	c.http = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return c
+}
+
+// Send posts the message and returns the response status. A status other than
+// 2xx is returned together with a *StatusError.
+func (c *Client) Send(ctx context.Context, m Message) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.URL, bytes.NewReader(m.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "calls-service-webhooks")
+	req.Header.Set(HeaderEvent, m.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(m.DeliveryID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(m.Secret, timestamp, m.Body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode}
+	}
+	return resp.StatusCode, nil
+}
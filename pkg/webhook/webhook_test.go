@@ -0,0 +1,115 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"calls-service/pkg/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"event":"call.created"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"sha256=bfa6d1c6549760344d77d1264f372225b77a047ce48b0d97b3a552dd28582007",
+		webhook.Sign("secret", 1700000000, []byte(`{"event":"call.created"}`)),
+	)
+}
+
+func TestSend(t *testing.T) {
+	body := []byte(`{"event":"call.created","call_id":1}`)
+
+	tests := []struct {
+		name           string
+		status         int
+		expectedStatus int
+		expectedErr    bool
+	}{
+		{name: "Delivered", status: http.StatusNoContent, expectedStatus: http.StatusNoContent},
+		{name: "Rejected", status: http.StatusInternalServerError, expectedStatus: http.StatusInternalServerError, expectedErr: true},
+		{name: "Redirect is not followed", status: http.StatusFound, expectedStatus: http.StatusFound, expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ := io.ReadAll(r.Body)
+				timestamp, _ := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+
+				assert.Equal(t, body, got)
+				assert.Equal(t, "call.created", r.Header.Get(webhook.HeaderEvent))
+				assert.Equal(t, "7", r.Header.Get(webhook.HeaderDelivery))
+				assert.True(t, webhook.Verify("secret", timestamp, got, r.Header.Get(webhook.HeaderSignature)))
+
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			status, err := webhook.NewClient(time.Second, webhook.AllowPrivateAddresses()).Send(context.Background(), webhook.Message{
+				URL:        server.URL,
+				Secret:     "secret",
+				Event:      "call.created",
+				DeliveryID: 7,
+				Body:       body,
+			})
+
+			assert.Equal(t, tt.expectedStatus, status)
+			if tt.expectedErr {
+				var statusErr *webhook.StatusError
+				assert.True(t, errors.As(err, &statusErr))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSendToPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback address")
+	}))
+	defer server.Close()
+
+	_, err := webhook.NewClient(time.Second).Send(context.Background(), webhook.Message{URL: server.URL, Secret: "secret", Event: "call.created", DeliveryID: 7})
+
+	assert.ErrorIs(t, err, webhook.ErrForbiddenAddress)
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{"93.184.215.14", true},
+		{"2606:2800:21f:cb07:6820:80da:af6b:8b2c", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.100.100.200", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.expected, webhook.IsPublic(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
@@ -8,6 +8,7 @@ package main
 
 import (
 	"log"
+	_ "time/tzdata" // callbacks need time zones, which the scratch image lacks
 
 	"calls-service/rest-service/internal/app"
 	"calls-service/rest-service/internal/config"
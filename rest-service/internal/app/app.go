@@ -2,20 +2,33 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	authpb "calls-service/auth-service/proto"
+	callspb "calls-service/rest-service/proto"
 
+	"calls-service/pkg/eventbus"
 	"calls-service/pkg/grpcserver"
 	"calls-service/pkg/httpserver"
 	"calls-service/pkg/logger"
+	"calls-service/pkg/phone"
 	"calls-service/pkg/postgres"
+	"calls-service/pkg/storage"
+	"calls-service/pkg/webhook"
 	"calls-service/rest-service/internal/config"
 	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/middleware"
+	"calls-service/rest-service/internal/entity"
 	"calls-service/rest-service/internal/repository"
 	"calls-service/rest-service/internal/usecase"
+	"calls-service/rest-service/internal/worker"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 )
 
 func Run(cfg *config.Config) {
@@ -23,6 +36,16 @@ func Run(cfg *config.Config) {
 
 	l.Info().Msg("Logger initialized")
 
+	phones, err := phone.NewParser(cfg.Phone.DefaultRegion)
+	if err != nil {
+		l.Fatal().Err(err).Msg("Invalid phone settings")
+	}
+
+	callbackZone, err := time.LoadLocation(cfg.Callbacks.DefaultTimezone)
+	if err != nil {
+		l.Fatal().Err(err).Msg("Invalid callback settings")
+	}
+
 	pg, err := postgres.New(cfg.PG.URL, cfg.PG.PoolMax)
 	if err != nil {
 		l.Fatal().Err(err).Msg("Failed to connect to PostgreSQL")
@@ -42,16 +65,81 @@ func Run(cfg *config.Config) {
 
 	authClient := authpb.NewAuthServiceClient(conn)
 
+	files, err := newStorage(ctx, cfg.Attachments)
+	if err != nil {
+		l.Fatal().Err(err).Msg("Failed to initialize attachment storage")
+	}
+
+	l.Info().Str("storage", cfg.Attachments.Storage).Msg("Attachment storage initialized")
+
+	publisher, err := newPublisher(ctx, cfg.Outbox, l)
+	if err != nil {
+		l.Fatal().Err(err).Msg("Failed to initialize event publisher")
+	}
+	defer func() { _ = publisher.Close() }()
+
+	l.Info().Str("publisher", cfg.Outbox.Publisher).Msg("Event publisher initialized")
+
 	// Use case
-	callsService := usecase.New(repository.New(pg), authClient)
+	callsService := usecase.New(repository.New(pg), authClient, usecase.SLA{
+		Deadlines: map[string]time.Duration{
+			entity.PriorityLow:      cfg.SLA.Low,
+			entity.PriorityNormal:   cfg.SLA.Normal,
+			entity.PriorityHigh:     cfg.SLA.High,
+			entity.PriorityCritical: cfg.SLA.Critical,
+		},
+		WarnBefore: cfg.SLA.WarnBefore,
+	}, cfg.Duplicates.Window, files, usecase.Webhooks{
+		Sender:       newWebhookClient(cfg.Webhooks),
+		BatchSize:    cfg.Webhooks.BatchSize,
+		Lease:        2 * cfg.Webhooks.Timeout,
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		BackoffBase:  cfg.Webhooks.BackoffBase,
+		BackoffMax:   cfg.Webhooks.BackoffMax,
+		AllowPrivate: cfg.Webhooks.AllowPrivate,
+	}, usecase.Outbox{
+		Publisher: publisher,
+		BatchSize: cfg.Outbox.BatchSize,
+	})
+
+	// Workers
+	slaWorker := worker.NewSLA(callsService, cfg.SLA.CheckInterval, l)
+	slaWorker.Start()
+
+	purgeWorker := worker.NewPurge(callsService, cfg.Trash.Retention, cfg.Trash.PurgeInterval, l)
+	purgeWorker.Start()
+
+	callbacksWorker := worker.NewCallbacks(callsService, cfg.Callbacks.CheckInterval, l)
+	callbacksWorker.Start()
+
+	webhooksWorker := worker.NewWebhooks(callsService, cfg.Webhooks.DeliverInterval, l)
+	webhooksWorker.Start()
+
+	outboxWorker := worker.NewOutbox(callsService, cfg.Outbox.RelayInterval, l)
+	outboxWorker.Start()
+
+	streamWorker := worker.NewStream(callsService, cfg.Stream.ReconnectInterval, l)
+	streamWorker.Start()
 
 	// Run server
 	httpServer := httpserver.New(cfg.HTTP.Port)
 
-	handler := controller.New(callsService, l)
-	controller.NewCallsRoutes(httpServer.Engine, handler)
+	handler := controller.New(callsService, l,
+		controller.PhoneParser(phones),
+		controller.AttachmentLimits(cfg.Attachments.MaxSize, cfg.Attachments.AllowedTypes),
+		controller.CallbackTimezone(callbackZone),
+		controller.AllowedOrigins(cfg.Stream.AllowedOrigins),
+	)
+	controller.NewCallsRoutes(httpServer.Engine, handler, cfg.HTTP.ExportWriteTimeout, cfg.HTTP.UploadTimeout)
+
+	grpcServer := grpcserver.New(cfg.CallsGRPC.Port,
+		grpc.ChainUnaryInterceptor(middleware.UnaryAuth()),
+		grpc.ChainStreamInterceptor(middleware.StreamAuth()),
+	)
+	grpcServer.RegisterService(&callspb.CallsService_ServiceDesc, controller.NewCallsServer(handler))
 
 	httpServer.Start()
+	grpcServer.Start()
 
 	l.Info().Msg("Server start")
 
@@ -63,11 +151,70 @@ func Run(cfg *config.Config) {
 		l.Info().Msgf("app - Run - signal: %s", s.String())
 	case err := <-httpServer.Notify():
 		l.Error().Err(err).Msg("app - Run - httpServer.Notify")
+	case err := <-grpcServer.Notify():
+		l.Error().Err(err).Msg("app - Run - grpcServer.Notify")
 	}
 
 	// Shutdown
+	// Open streams would hold the server until the shutdown timeout.
+	callsService.CloseCallStreams()
+
 	err = httpServer.Shutdown()
 	if err != nil {
 		l.Error().Err(err).Msg("app - Run - httpServer.Shutdown")
 	}
+
+	err = grpcServer.Shutdown()
+	if err != nil {
+		l.Error().Err(err).Msg("app - Run - grpcServer.Shutdown")
+	}
+
+	slaWorker.Stop()
+	purgeWorker.Stop()
+	callbacksWorker.Stop()
+	webhooksWorker.Stop()
+	outboxWorker.Stop()
+	streamWorker.Stop()
+}
+
+// newStorage returns the attachment storage selected by cfg.Storage.
+func newStorage(ctx context.Context, cfg config.Attachments) (storage.Storage, error) {
+	switch cfg.Storage {
+	case "local":
+		return storage.NewLocal(cfg.Dir)
+	case "s3":
+		return storage.NewS3(ctx, storage.S3Config{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+			Bucket:    cfg.S3.Bucket,
+			UseSSL:    cfg.S3.UseSSL,
+		})
+	}
+	return nil, fmt.Errorf("unknown attachment storage %q", cfg.Storage)
+}
+
+// newPublisher returns the event publisher selected by cfg.Publisher.
+func newPublisher(ctx context.Context, cfg config.Outbox, l zerolog.Logger) (eventbus.Publisher, error) {
+	switch cfg.Publisher {
+	case "log":
+		return eventbus.NewLog(l), nil
+	case "nats":
+		return eventbus.NewNATS(ctx, eventbus.NATSConfig{
+			URL:         cfg.NATS.URL,
+			Stream:      cfg.NATS.Stream,
+			Subject:     cfg.NATS.Subject,
+			DedupWindow: cfg.NATS.DedupWindow,
+		})
+	}
+	return nil, fmt.Errorf("unknown event publisher %q", cfg.Publisher)
+}
+
+// newWebhookClient returns the client that sends webhook deliveries.
+func newWebhookClient(cfg config.Webhooks) *webhook.Client {
+	if cfg.AllowPrivate {
+		return webhook.NewClient(cfg.Timeout, webhook.AllowPrivateAddresses())
+	}
+	return webhook.NewClient(cfg.Timeout)
 }
@@ -9,13 +9,28 @@ import (
 type Config struct {
 	HTTP
 	GRPC
+	CallsGRPC
+	SLA
+	Trash
+	Phone
+	Duplicates
+	Attachments
+	Callbacks
+	Webhooks
+	Outbox
+	Stream
 	Log c.Log
 	PG  c.PG
 	JWT c.JWT
 }
 
+// HTTP sets the server port. ExportWriteTimeout replaces the default write
+// timeout for call exports, and UploadTimeout the default read and write
+// timeouts for call imports and attachment uploads.
 type HTTP struct {
-	Port string `env-required:"true" env:"HTTP_PORT"`
+	Port               string        `env-required:"true" env:"HTTP_PORT"`
+	ExportWriteTimeout time.Duration `env:"HTTP_EXPORT_WRITE_TIMEOUT" envDefault:"10m"`
+	UploadTimeout      time.Duration `env:"HTTP_UPLOAD_TIMEOUT" envDefault:"10m"`
 }
 
 type GRPC struct {
@@ -24,6 +39,111 @@ type GRPC struct {
 	ConnectionTimeout time.Duration `env-required:"true" env:"GRPC_CLIENT_CONN_TIMEOUT"`
 }
 
+// CallsGRPC sets the port of the gRPC calls API.
+type CallsGRPC struct {
+	Port string `env:"CALLS_GRPC_PORT" envDefault:"50052"`
+}
+
+// SLA sets the time allowed to resolve a call of each priority. Calls are
+// flagged as at risk WarnBefore their deadline; the worker checks them every
+// CheckInterval.
+type SLA struct {
+	Low           time.Duration `env:"SLA_LOW" envDefault:"72h"`
+	Normal        time.Duration `env:"SLA_NORMAL" envDefault:"24h"`
+	High          time.Duration `env:"SLA_HIGH" envDefault:"8h"`
+	Critical      time.Duration `env:"SLA_CRITICAL" envDefault:"2h"`
+	WarnBefore    time.Duration `env:"SLA_WARN_BEFORE" envDefault:"1h"`
+	CheckInterval time.Duration `env:"SLA_CHECK_INTERVAL" envDefault:"1m"`
+}
+
+// Trash sets how long deleted calls are kept before the purge worker,
+// running every PurgeInterval, removes them for good.
+type Trash struct {
+	Retention     time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	PurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+// Phone sets the region, as an ISO 3166 code, in which phone numbers without
+// a country code are read.
+type Phone struct {
+	DefaultRegion string `env:"PHONE_DEFAULT_REGION" envDefault:"RU"`
+}
+
+// Duplicates sets how long after an open call another call with the same
+// phone number is refused as its duplicate. Zero turns the check off.
+type Duplicates struct {
+	Window time.Duration `env:"DUPLICATE_WINDOW" envDefault:"30m"`
+}
+
+// Attachments sets the maximum size of an attached file in bytes, its allowed
+// MIME types and where files are kept: in Dir with the local storage or in an
+// S3-compatible bucket with s3.
+type Attachments struct {
+	MaxSize      int64    `env:"ATTACHMENT_MAX_SIZE" envDefault:"20971520"`
+	AllowedTypes []string `env:"ATTACHMENT_ALLOWED_TYPES" envDefault:"image/png,image/jpeg,image/gif,image/webp,application/pdf,application/msword,application/vnd.openxmlformats-officedocument.wordprocessingml.document,text/plain,audio/mpeg,audio/wav,audio/ogg,audio/mp4"`
+	Storage      string   `env:"ATTACHMENT_STORAGE" envDefault:"local"`
+	Dir          string   `env:"ATTACHMENT_DIR" envDefault:"./data/attachments"`
+	S3           S3
+}
+
+// Callbacks sets the time zone of callbacks scheduled without one. The
+// reminder worker checks for due callbacks every CheckInterval.
+type Callbacks struct {
+	DefaultTimezone string        `env:"CALLBACK_DEFAULT_TIMEZONE" envDefault:"Europe/Moscow"`
+	CheckInterval   time.Duration `env:"CALLBACK_CHECK_INTERVAL" envDefault:"1m"`
+}
+
+// Webhooks sets how deliveries are sent: every DeliverInterval up to BatchSize
+// of them at once, each with Timeout. A failed delivery is retried up to
+// MaxAttempts times in all, after a delay doubling from BackoffBase up to
+// BackoffMax. Webhooks may only point to public addresses unless AllowPrivate
+// is set.
+type Webhooks struct {
+	Timeout         time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	MaxAttempts     int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	BackoffBase     time.Duration `env:"WEBHOOK_BACKOFF_BASE" envDefault:"30s"`
+	BackoffMax      time.Duration `env:"WEBHOOK_BACKOFF_MAX" envDefault:"1h"`
+	BatchSize       int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"20"`
+	DeliverInterval time.Duration `env:"WEBHOOK_DELIVER_INTERVAL" envDefault:"5s"`
+	AllowPrivate    bool          `env:"WEBHOOK_ALLOW_PRIVATE" envDefault:"false"`
+}
+
+// Outbox sets where changes of calls are published: to the service log with
+// log or to a NATS JetStream stream with nats. The relay worker publishes up
+// to BatchSize of them every RelayInterval.
+type Outbox struct {
+	Publisher     string        `env:"OUTBOX_PUBLISHER" envDefault:"log"`
+	BatchSize     int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	RelayInterval time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"1s"`
+	NATS          NATS
+}
+
+// NATS sets the server and the stream of published changes. The stream drops
+// a change published again within DedupWindow.
+type NATS struct {
+	URL         string        `env:"NATS_URL" envDefault:"nats://localhost:4222"`
+	Stream      string        `env:"NATS_STREAM" envDefault:"CALLS"`
+	Subject     string        `env:"NATS_SUBJECT" envDefault:"calls"`
+	DedupWindow time.Duration `env:"NATS_DEDUP_WINDOW" envDefault:"10m"`
+}
+
+// Stream sets how long to wait before listening for call changes again after
+// the database connection is lost, and the origins of other hosts whose pages
+// may open WebSockets.
+type Stream struct {
+	ReconnectInterval time.Duration `env:"STREAM_RECONNECT_INTERVAL" envDefault:"5s"`
+	AllowedOrigins    []string      `env:"STREAM_ALLOWED_ORIGINS"`
+}
+
+type S3 struct {
+	Endpoint  string `env:"S3_ENDPOINT"`
+	Region    string `env:"S3_REGION"`
+	AccessKey string `env:"S3_ACCESS_KEY"`
+	SecretKey string `env:"S3_SECRET_KEY"`
+	Bucket    string `env:"S3_BUCKET" envDefault:"attachments"`
+	UseSSL    bool   `env:"S3_USE_SSL" envDefault:"false"`
+}
+
 func Load() (*Config, error) {
 	cfg := &Config{}
 
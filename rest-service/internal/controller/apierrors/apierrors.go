@@ -3,3 +3,17 @@ package apierrors
 type Response struct {
 	Error string `json:"error"`
 }
+
+// DuplicateResponse is returned when a new call repeats a recent open call.
+type DuplicateResponse struct {
+	Error  string `json:"error"`
+	CallID int64  `json:"call_id"`
+	Link   string `json:"link"`
+}
+
+// TransitionResponse is returned when a call cannot move to the requested status.
+type TransitionResponse struct {
+	Error           string   `json:"error"`
+	CurrentStatus   string   `json:"current_status"`
+	AllowedStatuses []string `json:"allowed_statuses"`
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AssignCall hands a call over to another operator.
+//
+// @Summary Assign call
+// @Description Assigns a call to an operator. The call becomes visible to the assignee, who must exist in the auth service and, for a call of an organization, be a member of it
+// @Tags calls
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param input body entity.AssignCallDTO true "Assignee"
+// @Success 200 {object} entity.CallResponse "Assigned call"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 422 {object} apierrors.Response "Assignee not found or not a member of the organization of the call"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/assign [post]
+func (h *CallsHandler) AssignCall(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.AssignCallDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	call, err := h.u.AssignCall(c.Request.Context(), callID, userID, orgID, input.AssigneeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCallNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
+		case errors.Is(err, usecase.ErrAssigneeNotFound):
+			c.JSON(http.StatusUnprocessableEntity, apierrors.Response{Error: "Assignee not found"})
+		case errors.Is(err, usecase.ErrAssigneeNotMember):
+			c.JSON(http.StatusUnprocessableEntity, apierrors.Response{Error: "Assignee is not a member of the organization of the call"})
+		default:
+			h.l.Error().Err(err).Msg("Failed to assign call")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to assign call"})
+		}
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("assigneeID", input.AssigneeID).Msg("Call success assigned")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// UnassignCall removes the assignee of a call.
+//
+// @Summary Unassign call
+// @Description Removes the assignee of a call, leaving it visible to its creator and the members of its organization
+// @Tags calls
+// @Produce json
+// @Param id path int true "Call ID"
+// @Success 200 {object} entity.CallResponse "Unassigned call"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/unassign [post]
+func (h *CallsHandler) UnassignCall(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	call, err := h.u.UnassignCall(c.Request.Context(), callID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to unassign call")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to unassign call"})
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Msg("Call success unassigned")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
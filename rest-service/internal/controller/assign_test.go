@@ -0,0 +1,245 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAssignCall(t *testing.T) {
+	assigneeID := int64(456)
+	assigned := &entity.CallResponse{
+		ID:         1,
+		ClientName: "John Doe",
+		Status:     entity.StatusNew,
+		Version:    2,
+		AssigneeID: &assigneeID,
+	}
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		requestBody      string
+		mockCall         *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		expectedETag     string
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful assign",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			mockCall:         assigned,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: *assigned,
+			expectedETag:     `"2"`,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			requestBody:      `{"assignee_id":456}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Missing assignee",
+			callIDParam:      "1",
+			requestBody:      `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Assignee not found",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			mockErr:          usecase.ErrAssigneeNotFound,
+			expectedStatus:   http.StatusUnprocessableEntity,
+			expectedResponse: apierrors.Response{Error: "Assignee not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Assignee outside the organization",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			mockErr:          usecase.ErrAssigneeNotMember,
+			expectedStatus:   http.StatusUnprocessableEntity,
+			expectedResponse: apierrors.Response{Error: "Assignee is not a member of the organization of the call"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			requestBody:      `{"assignee_id":456}`,
+			mockErr:          errors.New("auth service unavailable"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to assign call"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("AssignCall", mock.Anything, int64(1), int64(123), int64(0), assigneeID).
+					Return(tt.mockCall, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callIDParam+"/assign", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.AssignCall(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "AssignCall")
+			}
+		})
+	}
+}
+
+func TestUnassignCall(t *testing.T) {
+	unassigned := &entity.CallResponse{
+		ID:         1,
+		ClientName: "John Doe",
+		Status:     entity.StatusNew,
+		Version:    3,
+	}
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		mockCall         *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful unassign",
+			callIDParam:      "1",
+			mockCall:         unassigned,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: *unassigned,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("UnassignCall", mock.Anything, int64(1), int64(123), int64(0)).
+					Return(tt.mockCall, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callIDParam+"/unassign", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.UnassignCall(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "UnassignCall")
+			}
+		})
+	}
+}
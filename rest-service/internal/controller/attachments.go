@@ -0,0 +1,315 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultMaxAttachmentSize = 20 << 20
+	// multipartOverhead is allowed on top of the file size for the multipart
+	// boundaries and headers of an upload.
+	multipartOverhead = 1 << 20
+	// maxAttachmentNameLength limits the file name of an attachment in bytes.
+	maxAttachmentNameLength = 255
+)
+
+// defaultAttachmentTypes are the MIME types of screenshots, documents and
+// voice recordings.
+var defaultAttachmentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"application/msword",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"text/plain",
+	"audio/mpeg",
+	"audio/wav",
+	"audio/ogg",
+	"audio/mp4",
+}
+
+type attachmentLimits struct {
+	maxSize int64
+	types   []string
+}
+
+// allows reports whether a file of the detected type may be attached.
+func (l attachmentLimits) allows(m *mimetype.MIME) bool {
+	for _, t := range l.types {
+		if m.Is(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddAttachment attaches a file to a call.
+//
+// @Summary Add attachment
+// @Description Uploads a file and attaches it to a call visible to the authenticated user. The file type is detected from its content and must be one of the allowed types: images, PDF and Word documents, plain text and audio recordings by default
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param file formData file true "File"
+// @Success 201 {object} entity.Attachment "Created attachment"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found"
+// @Failure 413 {object} apierrors.Response "File is too large"
+// @Failure 415 {object} apierrors.Response "Unsupported file type"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/attachments [post]
+func (h *CallsHandler) AddAttachment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.attachments.maxSize+multipartOverhead)
+
+	var input entity.AttachmentDTO
+	if err := c.ShouldBind(&input); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, apierrors.Response{Error: "File is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+	if input.File.Size > h.attachments.maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, apierrors.Response{Error: "File is too large"})
+		return
+	}
+	if input.File.Filename == "" || len(input.File.Filename) > maxAttachmentNameLength {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid file name"})
+		return
+	}
+
+	file, err := input.File.Open()
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to open attachment")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to add attachment"})
+		return
+	}
+	defer file.Close()
+
+	mtype, err := mimetype.DetectReader(file)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to read attachment")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to add attachment"})
+		return
+	}
+	if !h.attachments.allows(mtype) {
+		c.JSON(http.StatusUnsupportedMediaType, apierrors.Response{Error: "Unsupported file type"})
+		return
+	}
+
+	attachment, err := h.u.AddAttachment(c.Request.Context(), entity.Attachment{
+		CallID:      callID,
+		UserID:      userID,
+		FileName:    input.File.Filename,
+		ContentType: mtype.String(),
+		Size:        input.File.Size,
+	}, orgID, file)
+	if err != nil {
+		h.attachmentError(c, err, "Failed to add attachment")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("attachmentID", attachment.ID).Msg("Attachment success save")
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+// GetAttachments returns the attachments of a call.
+//
+// @Summary Get attachments
+// @Description Returns the attachments of a call visible to the authenticated user in upload order
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Call ID"
+// @Success 200 {array} entity.Attachment "Attachments"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/attachments [get]
+func (h *CallsHandler) GetAttachments(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	attachments, err := h.u.GetAttachments(c.Request.Context(), callID, userID, orgID)
+	if err != nil {
+		h.attachmentError(c, err, "Failed to get attachments")
+		return
+	}
+
+	c.JSON(http.StatusOK, attachments)
+}
+
+// DownloadAttachment sends the file of an attachment.
+//
+// @Summary Download attachment
+// @Description Returns the file of an attachment of a call visible to the authenticated user
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path int true "Call ID"
+// @Param attachmentID path int true "Attachment ID"
+// @Success 200 {file} file "Attachment file"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call or attachment not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/attachments/{attachmentID} [get]
+func (h *CallsHandler) DownloadAttachment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	attachmentID, err := strconv.ParseInt(c.Param("attachmentID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid attachment ID"})
+		return
+	}
+
+	attachment, file, err := h.u.GetAttachment(c.Request.Context(), callID, attachmentID, userID, orgID)
+	if err != nil {
+		h.attachmentError(c, err, "Failed to get attachment")
+		return
+	}
+	defer file.Close()
+
+	// The file is always offered for download, so that a browser never
+	// renders uploaded content as part of the site.
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, file, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+	})
+}
+
+// DeleteAttachment deletes an attachment uploaded by the authenticated user.
+//
+// @Summary Delete attachment
+// @Description Deletes an attachment together with its file. Only the user who uploaded it may delete an attachment
+// @Tags attachments
+// @Param id path int true "Call ID"
+// @Param attachmentID path int true "Attachment ID"
+// @Success 204 "Deleted"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Attachment belongs to another user"
+// @Failure 404 {object} apierrors.Response "Call or attachment not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/attachments/{attachmentID} [delete]
+func (h *CallsHandler) DeleteAttachment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	attachmentID, err := strconv.ParseInt(c.Param("attachmentID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid attachment ID"})
+		return
+	}
+
+	if err := h.u.DeleteAttachment(c.Request.Context(), callID, attachmentID, userID, orgID); err != nil {
+		h.attachmentError(c, err, "Failed to delete attachment")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("attachmentID", attachmentID).Msg("Attachment success deleted")
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *CallsHandler) attachmentError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrCallNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+	case errors.Is(err, usecase.ErrAttachmentNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Attachment not found"})
+	case errors.Is(err, usecase.ErrNotAttachmentAuthor):
+		c.JSON(http.StatusForbidden, apierrors.Response{Error: "Attachment belongs to another user"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
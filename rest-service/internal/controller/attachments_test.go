@@ -0,0 +1,306 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddAttachment(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	attachment := entity.Attachment{ID: 7, CallID: 1, UserID: 123, FileName: "screen.png", ContentType: "image/png", Size: int64(len(png)), CreatedAt: createdAt}
+
+	tests := []struct {
+		name             string
+		callID           string
+		filename         string
+		file             []byte
+		opts             []controller.Option
+		mockReturn       *entity.Attachment
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful upload",
+			callID:           "1",
+			filename:         "screen.png",
+			file:             png,
+			mockReturn:       &attachment,
+			expectedStatus:   http.StatusCreated,
+			expectedResponse: attachment,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Call not found",
+			callID:           "1",
+			filename:         "screen.png",
+			file:             png,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Storage error",
+			callID:           "1",
+			filename:         "screen.png",
+			file:             png,
+			mockErr:          errors.New("storage error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to add attachment"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Type detected from content",
+			callID:           "1",
+			filename:         "screen.png",
+			file:             []byte("\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00"),
+			expectedStatus:   http.StatusUnsupportedMediaType,
+			expectedResponse: apierrors.Response{Error: "Unsupported file type"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Type not allowed",
+			callID:           "1",
+			filename:         "notes.txt",
+			file:             []byte("plain text"),
+			opts:             []controller.Option{controller.AttachmentLimits(1024, []string{"image/png"})},
+			expectedStatus:   http.StatusUnsupportedMediaType,
+			expectedResponse: apierrors.Response{Error: "Unsupported file type"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "File too large",
+			callID:           "1",
+			filename:         "screen.png",
+			file:             png,
+			opts:             []controller.Option{controller.AttachmentLimits(16, []string{"image/png"})},
+			expectedStatus:   http.StatusRequestEntityTooLarge,
+			expectedResponse: apierrors.Response{Error: "File is too large"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Missing file",
+			callID:           "1",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid call ID",
+			callID:           "abc",
+			filename:         "screen.png",
+			file:             png,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("AddAttachment", mock.Anything, entity.Attachment{
+					CallID:      1,
+					UserID:      123,
+					FileName:    tt.filename,
+					ContentType: "image/png",
+					Size:        int64(len(tt.file)),
+				}, int64(0), mock.Anything).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			var body bytes.Buffer
+			mw := multipart.NewWriter(&body)
+			if tt.filename != "" {
+				fw, _ := mw.CreateFormFile("file", tt.filename)
+				fw.Write(tt.file)
+			}
+			mw.Close()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.callID}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callID+"/attachments", &body)
+			c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+
+			handler := controller.New(mockUseCase, zerolog.Nop(), tt.opts...)
+
+			handler.AddAttachment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusCreated {
+				var response entity.Attachment
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "AddAttachment")
+			}
+		})
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	attachment := entity.Attachment{ID: 7, CallID: 1, UserID: 123, FileName: "договор.pdf", ContentType: "application/pdf", Size: 5}
+
+	tests := []struct {
+		name                string
+		attachmentID        string
+		mockReturn          *entity.Attachment
+		mockFile            io.ReadCloser
+		mockErr             error
+		expectedStatus      int
+		expectedBody        string
+		expectedDisposition string
+		shouldCallMock      bool
+	}{
+		{
+			name:                "Successful download",
+			attachmentID:        "7",
+			mockReturn:          &attachment,
+			mockFile:            io.NopCloser(strings.NewReader("%PDF-")),
+			expectedStatus:      http.StatusOK,
+			expectedBody:        "%PDF-",
+			expectedDisposition: "attachment; filename*=utf-8''%D0%B4%D0%BE%D0%B3%D0%BE%D0%B2%D0%BE%D1%80.pdf",
+			shouldCallMock:      true,
+		},
+		{
+			name:           "Attachment not found",
+			attachmentID:   "7",
+			mockErr:        usecase.ErrAttachmentNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":"Attachment not found"}`,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Call not found",
+			attachmentID:   "7",
+			mockErr:        usecase.ErrCallNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":"Call not found"}`,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Invalid attachment ID",
+			attachmentID:   "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":"Invalid attachment ID"}`,
+			shouldCallMock: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetAttachment", mock.Anything, int64(1), int64(7), int64(123), int64(0)).Return(tt.mockReturn, tt.mockFile, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "attachmentID", Value: tt.attachmentID}}
+			c.Request = httptest.NewRequest("GET", "/calls/1/attachments/"+tt.attachmentID, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DownloadAttachment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+				assert.Equal(t, tt.expectedDisposition, w.Header().Get("Content-Disposition"))
+				assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetAttachment")
+			}
+		})
+	}
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+	}{
+		{
+			name:           "Successful deletion",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:             "Not the uploader",
+			mockErr:          usecase.ErrNotAttachmentAuthor,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Attachment belongs to another user"},
+		},
+		{
+			name:             "Attachment not found",
+			mockErr:          usecase.ErrAttachmentNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Attachment not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.On("DeleteAttachment", mock.Anything, int64(1), int64(7), int64(123), int64(0)).Return(tt.mockErr)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "attachmentID", Value: "7"}}
+			c.Request = httptest.NewRequest("DELETE", "/calls/1/attachments/7", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DeleteAttachment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.mockErr != nil {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+		})
+	}
+}
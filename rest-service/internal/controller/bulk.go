@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"net/http"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// BulkCreateCalls creates several calls in one request.
+//
+// @Summary Bulk create calls
+// @Description Creates up to 100 calls. Every item is validated like POST /calls. In atomic mode (default) nothing is created if any item is invalid; in best_effort mode the valid items are created. Unless force is set, an item repeating a recent open call or an earlier item fails as a duplicate, like in POST /calls
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param input body entity.BulkCreateCallsDTO true "Calls to create"
+// @Success 200 {object} entity.BulkResponse "Per-item results"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 422 {object} entity.BulkResponse "Atomic request rolled back"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/bulk [post]
+func (h *CallsHandler) BulkCreateCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.BulkCreateCallsDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+	atomic := input.Mode != entity.BulkBestEffort
+
+	results := make([]entity.BulkItemResult, len(input.Items))
+	var calls []entity.Call
+	var positions []int
+	for i, item := range input.Items {
+		results[i].Index = i
+		if binding.Validator.ValidateStruct(&item) != nil {
+			results[i].Status, results[i].Error = entity.BulkItemFailed, "Invalid request format"
+			continue
+		}
+
+		number, err := h.phones.Parse(item.PhoneNumber)
+		if err != nil {
+			results[i].Status, results[i].Error = entity.BulkItemFailed, "Invalid phone number format"
+			continue
+		}
+
+		calls = append(calls, entity.Call{
+			ClientName:  item.ClientName,
+			PhoneNumber: item.PhoneNumber,
+			PhoneE164:   number.E164(),
+			Description: item.Description,
+			Status:      entity.StatusNew,
+			UserID:      userID,
+			OrgID:       orgID,
+			Priority:    item.Priority,
+		})
+		positions = append(positions, i)
+	}
+
+	if len(calls) > 0 && (!atomic || len(calls) == len(input.Items)) {
+		saved, err := h.u.BulkCreateCalls(c.Request.Context(), calls, atomic, input.Force)
+		if err != nil {
+			h.l.Error().Err(err).Msg("Failed to bulk create calls")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to create calls"})
+			return
+		}
+		for i, res := range saved {
+			res.Index = positions[i]
+			results[positions[i]] = res
+		}
+	}
+
+	for i := range results {
+		if results[i].Status == "" {
+			results[i].Status = entity.BulkItemSkipped
+		}
+	}
+
+	h.bulkResponse(c, results, atomic)
+}
+
+// BulkUpdateCallStatus changes the status of several calls in one request.
+//
+// @Summary Bulk update call status
+// @Description Changes the status of up to 100 calls following the status workflow. In atomic mode (default) nothing is changed if any item fails; in best_effort mode the allowed changes are applied
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param input body entity.BulkUpdateStatusDTO true "Status changes"
+// @Success 200 {object} entity.BulkResponse "Per-item results"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 422 {object} entity.BulkResponse "Atomic request rolled back"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/bulk/status [post]
+func (h *CallsHandler) BulkUpdateCallStatus(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.BulkUpdateStatusDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+	atomic := input.Mode != entity.BulkBestEffort
+
+	changes := make([]entity.StatusChange, len(input.Items))
+	for i, item := range input.Items {
+		changes[i] = entity.StatusChange{CallID: item.ID, UserID: userID, OrgID: orgID, To: item.Status}
+	}
+
+	results, err := h.u.BulkUpdateCallStatus(c.Request.Context(), userID, orgID, changes, atomic)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to bulk update call status")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to update call status"})
+		return
+	}
+
+	h.bulkResponse(c, results, atomic)
+}
+
+// BulkDeleteCalls moves several calls to the trash in one request.
+//
+// @Summary Bulk delete calls
+// @Description Moves up to 100 calls to the trash. In atomic mode (default) nothing is deleted if any call is not found; in best_effort mode the found calls are deleted
+// @Tags bulk
+// @Accept json
+// @Produce json
+// @Param input body entity.BulkDeleteDTO true "Call IDs"
+// @Success 200 {object} entity.BulkResponse "Per-item results"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 422 {object} entity.BulkResponse "Atomic request rolled back"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/bulk/delete [post]
+func (h *CallsHandler) BulkDeleteCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.BulkDeleteDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+	atomic := input.Mode != entity.BulkBestEffort
+
+	results, err := h.u.BulkDeleteCalls(c.Request.Context(), userID, orgID, input.IDs, atomic)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to bulk delete calls")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to delete calls"})
+		return
+	}
+
+	h.bulkResponse(c, results, atomic)
+}
+
+// bulkResponse answers 422 when an atomic request was rolled back and 200 otherwise.
+func (h *CallsHandler) bulkResponse(c *gin.Context, results []entity.BulkItemResult, atomic bool) {
+	resp := entity.BulkResponse{Items: results}
+	for _, res := range results {
+		switch res.Status {
+		case entity.BulkItemOK:
+			resp.Succeeded++
+		case entity.BulkItemFailed:
+			resp.Failed++
+		}
+	}
+
+	status := http.StatusOK
+	if atomic && resp.Failed > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	h.l.Info().Int("succeeded", resp.Succeeded).Int("failed", resp.Failed).Msg("Bulk operation done")
+
+	c.JSON(status, resp)
+}
@@ -0,0 +1,364 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBulkCreateCalls(t *testing.T) {
+	validItem := `{"client_name":"John Doe","phone_number":"+79876543211","description":"Test call"}`
+	badPhoneItem := `{"client_name":"Jane Doe","phone_number":"invalid-phone","description":"Test call"}`
+
+	tests := []struct {
+		name             string
+		requestBody      string
+		mockResults      []entity.BulkItemResult
+		mockErr          error
+		expectedCalls    int
+		expectedAtomic   bool
+		expectedForce    bool
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful atomic create",
+			requestBody:    `{"items":[` + validItem + `,` + validItem + `]}`,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 10, Status: entity.BulkItemOK}, {Index: 1, ID: 11, Status: entity.BulkItemOK}},
+			expectedCalls:  2,
+			expectedAtomic: true,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 2,
+				Items:     []entity.BulkItemResult{{Index: 0, ID: 10, Status: entity.BulkItemOK}, {Index: 1, ID: 11, Status: entity.BulkItemOK}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Atomic create with invalid item",
+			requestBody:    `{"items":[` + validItem + `,` + badPhoneItem + `]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: entity.BulkResponse{
+				Failed: 1,
+				Items: []entity.BulkItemResult{
+					{Index: 0, Status: entity.BulkItemSkipped},
+					{Index: 1, Status: entity.BulkItemFailed, Error: "Invalid phone number format"},
+				},
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:           "Best-effort create with invalid item",
+			requestBody:    `{"mode":"best_effort","items":[` + badPhoneItem + `,{"client_name":"John Doe"},` + validItem + `]}`,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 12, Status: entity.BulkItemOK}},
+			expectedCalls:  1,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 1,
+				Failed:    2,
+				Items: []entity.BulkItemResult{
+					{Index: 0, Status: entity.BulkItemFailed, Error: "Invalid phone number format"},
+					{Index: 1, Status: entity.BulkItemFailed, Error: "Invalid request format"},
+					{Index: 2, ID: 12, Status: entity.BulkItemOK},
+				},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:        "Best-effort create with duplicate",
+			requestBody: `{"mode":"best_effort","items":[` + validItem + `,` + validItem + `]}`,
+			mockResults: []entity.BulkItemResult{
+				{Index: 0, ID: 13, Status: entity.BulkItemOK},
+				{Index: 1, Status: entity.BulkItemFailed, Error: "duplicate of call 13"},
+			},
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 1,
+				Failed:    1,
+				Items: []entity.BulkItemResult{
+					{Index: 0, ID: 13, Status: entity.BulkItemOK},
+					{Index: 1, Status: entity.BulkItemFailed, Error: "duplicate of call 13"},
+				},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Forced create",
+			requestBody:    `{"force":true,"items":[` + validItem + `]}`,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 14, Status: entity.BulkItemOK}},
+			expectedCalls:  1,
+			expectedAtomic: true,
+			expectedForce:  true,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 1,
+				Items:     []entity.BulkItemResult{{Index: 0, ID: 14, Status: entity.BulkItemOK}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:             "Empty items",
+			requestBody:      `{"items":[]}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			requestBody:      `{"items":[` + validItem + `]}`,
+			mockErr:          errors.New("db error"),
+			expectedCalls:    1,
+			expectedAtomic:   true,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to create calls"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("BulkCreateCalls", mock.Anything, mock.MatchedBy(func(calls []entity.Call) bool {
+					return len(calls) == tt.expectedCalls && calls[0].UserID == 123
+				}), tt.expectedAtomic, tt.expectedForce).
+					Return(tt.mockResults, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/calls/bulk", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.BulkCreateCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK || w.Code == http.StatusUnprocessableEntity {
+				var response entity.BulkResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "BulkCreateCalls")
+			}
+		})
+	}
+}
+
+func TestBulkUpdateCallStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		expectedAtomic   bool
+		mockResults      []entity.BulkItemResult
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful update",
+			requestBody:    `{"items":[{"id":1,"status":"in_progress"}]}`,
+			expectedAtomic: true,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemOK}},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 1,
+				Items:     []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemOK}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Atomic update rolled back",
+			requestBody:    `{"mode":"atomic","items":[{"id":1,"status":"in_progress"}]}`,
+			expectedAtomic: true,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemFailed, Error: "call not found"}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: entity.BulkResponse{
+				Failed: 1,
+				Items:  []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemFailed, Error: "call not found"}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Best-effort update with failed item",
+			requestBody:    `{"mode":"best_effort","items":[{"id":1,"status":"in_progress"}]}`,
+			expectedAtomic: false,
+			mockResults:    []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemFailed, Error: "call not found"}},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Failed: 1,
+				Items:  []entity.BulkItemResult{{Index: 0, ID: 1, Status: entity.BulkItemFailed, Error: "call not found"}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:             "Unknown mode",
+			requestBody:      `{"mode":"sometimes","items":[{"id":1,"status":"in_progress"}]}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			requestBody:      `{"items":[{"id":1,"status":"in_progress"}]}`,
+			expectedAtomic:   true,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to update call status"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("BulkUpdateCallStatus", mock.Anything, int64(123), int64(0),
+					[]entity.StatusChange{{CallID: 1, UserID: 123, To: entity.StatusInProgress}}, tt.expectedAtomic).
+					Return(tt.mockResults, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/calls/bulk/status", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.BulkUpdateCallStatus(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK || w.Code == http.StatusUnprocessableEntity {
+				var response entity.BulkResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "BulkUpdateCallStatus")
+			}
+		})
+	}
+}
+
+func TestBulkDeleteCalls(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		mockResults      []entity.BulkItemResult
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:        "Successful delete",
+			requestBody: `{"ids":[1,2]}`,
+			mockResults: []entity.BulkItemResult{
+				{Index: 0, ID: 1, Status: entity.BulkItemOK},
+				{Index: 1, ID: 2, Status: entity.BulkItemOK},
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.BulkResponse{
+				Succeeded: 2,
+				Items: []entity.BulkItemResult{
+					{Index: 0, ID: 1, Status: entity.BulkItemOK},
+					{Index: 1, ID: 2, Status: entity.BulkItemOK},
+				},
+			},
+			setupContext:   func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock: true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			requestBody:      `{"ids":[1,2]}`,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			requestBody:      `{"ids":[1,2]}`,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to delete calls"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("BulkDeleteCalls", mock.Anything, int64(123), int64(0), []int64{1, 2}, true).
+					Return(tt.mockResults, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Request = httptest.NewRequest("POST", "/calls/bulk/delete", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.BulkDeleteCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.BulkResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "BulkDeleteCalls")
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// localCallbackLayouts are the accepted formats of a callback time given
+// without an offset.
+var localCallbackLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+var (
+	errInvalidTimezone     = errors.New("invalid time zone")
+	errInvalidCallbackTime = errors.New("invalid callback time")
+)
+
+// parseCallbackTime reads at, either in RFC 3339 format or as a local time in
+// the zone tz, and returns it in that zone. An empty tz selects def.
+func parseCallbackTime(at, tz string, def *time.Location) (time.Time, *time.Location, error) {
+	loc := def
+	if tz != "" {
+		var err error
+		// "Local" is the zone of the server, which means nothing to the client.
+		if loc, err = time.LoadLocation(tz); err != nil || tz == "Local" {
+			return time.Time{}, nil, errInvalidTimezone
+		}
+	}
+
+	if t, err := time.Parse(time.RFC3339, at); err == nil {
+		return t.In(loc), loc, nil
+	}
+	for _, layout := range localCallbackLayouts {
+		if t, err := time.ParseInLocation(layout, at, loc); err == nil {
+			return t, loc, nil
+		}
+	}
+	return time.Time{}, nil, errInvalidCallbackTime
+}
+
+// ScheduleCallback sets the time to call the client back.
+//
+// @Summary Schedule callback
+// @Description Schedules a callback of a call visible to the authenticated user, replacing the previous one. The time is given either with an offset in RFC 3339 format or as a local time (YYYY-MM-DDTHH:MM[:SS]) in the IANA time zone timezone. The callback is kept and returned in that time zone, the default time zone of the service unless set. A reminder is logged when the callback is due
+// @Tags callbacks
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param input body entity.ScheduleCallbackDTO true "Callback time"
+// @Success 200 {object} entity.CallResponse "Call with the callback"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/callback [put]
+func (h *CallsHandler) ScheduleCallback(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.ScheduleCallbackDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	at, loc, err := parseCallbackTime(input.At, input.Timezone, h.callbackZone)
+	if err != nil {
+		if errors.Is(err, errInvalidTimezone) {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid time zone"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid callback time"})
+		return
+	}
+
+	call, err := h.u.ScheduleCallback(c.Request.Context(), entity.Callback{
+		CallID:   callID,
+		UserID:   userID,
+		OrgID:    orgID,
+		At:       at,
+		Timezone: loc.String(),
+	})
+	if err != nil {
+		h.callbackError(c, err, "Failed to schedule callback")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Time("callbackAt", at).Msg("Callback success scheduled")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// SnoozeCallback postpones the callback of a call.
+//
+// @Summary Snooze callback
+// @Description Postpones the callback of a call by the given number of minutes, at most a week, counted from the callback time or from now if it is overdue. The reminder is sent again when the new time comes
+// @Tags callbacks
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param input body entity.SnoozeCallbackDTO true "Delay"
+// @Success 200 {object} entity.CallResponse "Call with the postponed callback"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 409 {object} apierrors.Response "Call has no scheduled callback"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/callback/snooze [post]
+func (h *CallsHandler) SnoozeCallback(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.SnoozeCallbackDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	call, err := h.u.SnoozeCallback(c.Request.Context(), entity.CallbackSnooze{
+		CallID: callID,
+		UserID: userID,
+		OrgID:  orgID,
+		By:     time.Duration(input.Minutes) * time.Minute,
+	})
+	if err != nil {
+		h.callbackError(c, err, "Failed to snooze callback")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int("minutes", input.Minutes).Msg("Callback success snoozed")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// CancelCallback removes the callback of a call.
+//
+// @Summary Cancel callback
+// @Description Removes the scheduled callback of a call visible to the authenticated user
+// @Tags callbacks
+// @Produce json
+// @Param id path int true "Call ID"
+// @Success 200 {object} entity.CallResponse "Call without the callback"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 409 {object} apierrors.Response "Call has no scheduled callback"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/callback [delete]
+func (h *CallsHandler) CancelCallback(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	call, err := h.u.CancelCallback(c.Request.Context(), callID, userID, orgID)
+	if err != nil {
+		h.callbackError(c, err, "Failed to cancel callback")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Msg("Callback success cancelled")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// GetUpcomingCallbacks returns the calls to be called back soon.
+//
+// @Summary Get upcoming callbacks
+// @Description Returns the open calls visible to the authenticated user with a callback due within the given time, overdue callbacks included, the earliest first
+// @Tags callbacks
+// @Produce json
+// @Param within query string false "Time ahead as a Go duration, from 1m to 720h, 24h by default"
+// @Param limit query int false "Maximum number of calls, 50 by default" minimum(1) maximum(100)
+// @Success 200 {array} entity.CallResponse "Calls with upcoming callbacks"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/callbacks/upcoming [get]
+func (h *CallsHandler) GetUpcomingCallbacks(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.UpcomingCallbacksDTO
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	calls, err := h.u.GetUpcomingCallbacks(c.Request.Context(), userID, orgID, input.Within, input.Limit)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get upcoming callbacks")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get upcoming callbacks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, calls)
+}
+
+func (h *CallsHandler) callbackError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrCallNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
+	case errors.Is(err, usecase.ErrNoCallback):
+		c.JSON(http.StatusConflict, apierrors.Response{Error: "Call has no scheduled callback"})
+	case errors.Is(err, usecase.ErrCallbackInPast):
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Callback time must be in the future"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
@@ -0,0 +1,372 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScheduleCallback(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		callID           string
+		requestBody      string
+		expectedAt       time.Time
+		expectedTimezone string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Local time in time zone",
+			callID:           "1",
+			requestBody:      `{"at":"2030-03-01T15:30","timezone":"Asia/Yekaterinburg"}`,
+			expectedAt:       time.Date(2030, 3, 1, 10, 30, 0, 0, time.UTC),
+			expectedTimezone: "Asia/Yekaterinburg",
+			expectedStatus:   http.StatusOK,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Time with offset in default time zone",
+			callID:           "1",
+			requestBody:      `{"at":"2030-03-01T10:30:00Z"}`,
+			expectedAt:       time.Date(2030, 3, 1, 13, 30, 0, 0, moscow),
+			expectedTimezone: "Europe/Moscow",
+			expectedStatus:   http.StatusOK,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Time in the past",
+			callID:           "1",
+			requestBody:      `{"at":"2020-03-01T15:30:00","timezone":"Europe/Moscow"}`,
+			expectedAt:       time.Date(2020, 3, 1, 15, 30, 0, 0, moscow),
+			expectedTimezone: "Europe/Moscow",
+			mockErr:          usecase.ErrCallbackInPast,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Callback time must be in the future"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Call not found",
+			callID:           "1",
+			requestBody:      `{"at":"2030-03-01T10:30:00Z"}`,
+			expectedAt:       time.Date(2030, 3, 1, 10, 30, 0, 0, time.UTC),
+			expectedTimezone: "Europe/Moscow",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid time zone",
+			callID:           "1",
+			requestBody:      `{"at":"2030-03-01T15:30","timezone":"Mars/Olympus"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid time zone"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Server time zone",
+			callID:           "1",
+			requestBody:      `{"at":"2030-03-01T15:30","timezone":"Local"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid time zone"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid time",
+			callID:           "1",
+			requestBody:      `{"at":"tomorrow"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid callback time"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Missing time",
+			callID:           "1",
+			requestBody:      `{"timezone":"Europe/Moscow"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid call ID",
+			callID:           "abc",
+			requestBody:      `{"at":"2030-03-01T10:30:00Z"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				at := tt.expectedAt.In(mustLoadLocation(t, tt.expectedTimezone))
+				mockUseCase.On("ScheduleCallback", mock.Anything, mock.MatchedBy(func(cb entity.Callback) bool {
+					return cb.CallID == 1 && cb.UserID == 123 && cb.At.Equal(at) && cb.Timezone == tt.expectedTimezone
+				})).Return(&entity.CallResponse{ID: 1, Version: 2, CallbackAt: &at, CallbackTimezone: tt.expectedTimezone}, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.callID}}
+			c.Request = httptest.NewRequest("PUT", "/calls/"+tt.callID+"/callback", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop(), controller.CallbackTimezone(moscow))
+
+			handler.ScheduleCallback(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTimezone, response.CallbackTimezone)
+				assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "ScheduleCallback")
+			}
+		})
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestSnoozeCallback(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful snooze",
+			requestBody:    `{"minutes":15}`,
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:             "No callback",
+			requestBody:      `{"minutes":15}`,
+			mockErr:          usecase.ErrNoCallback,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "Call has no scheduled callback"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Storage error",
+			requestBody:      `{"minutes":15}`,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to snooze callback"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Too long",
+			requestBody:      `{"minutes":20000}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("SnoozeCallback", mock.Anything, entity.CallbackSnooze{CallID: 1, UserID: 123, By: 15 * time.Minute}).
+					Return(&entity.CallResponse{ID: 1, Version: 3}, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("POST", "/calls/1/callback/snooze", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.SnoozeCallback(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code != http.StatusOK {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "SnoozeCallback")
+			}
+		})
+	}
+}
+
+func TestCancelCallback(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+	}{
+		{
+			name:           "Successful cancellation",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:             "No callback",
+			mockErr:          usecase.ErrNoCallback,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "Call has no scheduled callback"},
+		},
+		{
+			name:             "Call not found",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.On("CancelCallback", mock.Anything, int64(1), int64(123), int64(0)).Return(&entity.CallResponse{ID: 1, Version: 3}, tt.mockErr)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("DELETE", "/calls/1/callback", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.CancelCallback(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.mockErr != nil {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestGetUpcomingCallbacks(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedWithin time.Duration
+		expectedLimit  int
+		expectedStatus int
+		shouldCallMock bool
+	}{
+		{
+			name:           "Default window",
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Custom window",
+			query:          "?within=2h&limit=10",
+			expectedWithin: 2 * time.Hour,
+			expectedLimit:  10,
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Window too long",
+			query:          "?within=1000h",
+			expectedStatus: http.StatusBadRequest,
+			shouldCallMock: false,
+		},
+		{
+			name:           "Invalid window",
+			query:          "?within=soon",
+			expectedStatus: http.StatusBadRequest,
+			shouldCallMock: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetUpcomingCallbacks", mock.Anything, int64(123), int64(0), tt.expectedWithin, tt.expectedLimit).
+					Return([]entity.CallResponse{{ID: 1}}, nil)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("GET", "/calls/callbacks/upcoming"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetUpcomingCallbacks(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response []entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response, 1)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, apierrors.Response{Error: "Invalid query parameters"}, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetUpcomingCallbacks")
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package controller
 import (
 	"errors"
 	"net/http"
-	"regexp"
 	"strconv"
 
 	"calls-service/rest-service/internal/controller/apierrors"
@@ -13,19 +12,19 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const statusOpen = "открыта"
-
 // SaveCall handles the creation of a new call record.
 //
 // @Summary Create a new call
-// @Description Saves a new call with client name, phone number, and description
+// @Description Saves a new call with client name, phone number, and description. A call whose phone number already has an open call created within the duplicate window is refused unless force is set
 // @Tags calls
 // @Accept json
 // @Produce json
 // @Param input body entity.CallDTO true "Call data"
+// @Param force query bool false "Create the call even if it looks like a duplicate"
 // @Success 201 {string} string "Created"
 // @Failure 400 {object} apierrors.Response "Invalid input"
 // @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 409 {object} apierrors.DuplicateResponse "Duplicate of an open call"
 // @Failure 500 {object} apierrors.Response "Internal server error"
 // @Router /calls [post]
 func (h *CallsHandler) SaveCall(c *gin.Context) {
@@ -36,7 +35,14 @@ func (h *CallsHandler) SaveCall(c *gin.Context) {
 		return
 	}
 
-	if !ValidatePhoneNumber(input.PhoneNumber) {
+	var query entity.SaveCallQueryDTO
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	number, err := h.phones.Parse(input.PhoneNumber)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid phone number format"})
 		return
 	}
@@ -52,17 +58,29 @@ func (h *CallsHandler) SaveCall(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
 		return
 	}
+	orgID := c.GetInt64("org_id")
 
 	newCall := entity.Call{
 		ClientName:  input.ClientName,
 		PhoneNumber: input.PhoneNumber,
+		PhoneE164:   number.E164(),
 		Description: input.Description,
-		Status:      statusOpen,
+		Status:      entity.StatusNew,
 		UserID:      userID,
+		OrgID:       orgID,
+		Priority:    input.Priority,
 	}
 
-	err := h.u.SaveCall(c.Request.Context(), newCall)
-	if err != nil {
+	if _, err := h.u.SaveCall(c.Request.Context(), newCall, query.Force); err != nil {
+		var dupErr *usecase.DuplicateCallError
+		if errors.As(err, &dupErr) {
+			c.JSON(http.StatusConflict, apierrors.DuplicateResponse{
+				Error:  "Call duplicates an open call",
+				CallID: dupErr.CallID,
+				Link:   "/calls/" + strconv.FormatInt(dupErr.CallID, 10),
+			})
+			return
+		}
 		h.l.Error().Err(err).Msg("Failed to save call")
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to save call"})
 		return
@@ -74,17 +92,154 @@ func (h *CallsHandler) SaveCall(c *gin.Context) {
 	c.Writer.Write([]byte{})
 }
 
-// GetUserCalls returns all calls for the authenticated user.
+// GetUserCalls returns a page of calls for the authenticated user.
 //
 // @Summary Get user calls
-// @Description Retrieves a list of calls belonging to the authenticated user
+// @Description Retrieves a page of calls created by or assigned to the authenticated user using keyset pagination
 // @Tags calls
 // @Produce json
-// @Success 200 {array} entity.CallResponse "List of calls"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param status query string false "Filter by status"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param phone_number query string false "Filter by part of phone number"
+// @Param client_name query string false "Filter by part of client name"
+// @Param tag query string false "Filter by tag name (case-insensitive)"
+// @Param assigned_to query string false "Only calls assigned to the authenticated user" Enums(me)
+// @Param priority query string false "Filter by priority" Enums(low, normal, high, critical)
+// @Param sla query string false "Filter by SLA state" Enums(ok, at_risk, breached)
+// @Param sort query string false "Sort field" Enums(created_at, client_name, status, id)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {object} entity.CallsListResponse "Page of calls"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
 // @Failure 401 {object} apierrors.Response "Unauthorized"
 // @Failure 500 {object} apierrors.Response "Internal server error"
 // @Router /calls [get]
 func (h *CallsHandler) GetUserCalls(c *gin.Context) {
+	h.listCalls(c, false)
+}
+
+// GetTrash returns a page of deleted calls that can still be restored.
+//
+// @Summary Get deleted calls
+// @Description Retrieves a page of deleted calls created by or assigned to the authenticated user. Accepts the same parameters as GET /calls
+// @Tags calls
+// @Produce json
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param status query string false "Filter by status"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param phone_number query string false "Filter by part of phone number"
+// @Param client_name query string false "Filter by part of client name"
+// @Param tag query string false "Filter by tag name (case-insensitive)"
+// @Param sort query string false "Sort field" Enums(created_at, client_name, status, id)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {object} entity.CallsListResponse "Page of deleted calls"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/trash [get]
+func (h *CallsHandler) GetTrash(c *gin.Context) {
+	h.listCalls(c, true)
+}
+
+func (h *CallsHandler) listCalls(c *gin.Context, deleted bool) {
+	query, ok := bindCallsPage(c)
+	if !ok {
+		return
+	}
+	query.Deleted = deleted
+
+	page, err := h.u.GetUserCalls(c.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid cursor"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to get user calls")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get user calls"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.CallsListResponse{
+		Items:      page.Items,
+		NextCursor: encodeCursor(page.Next),
+	})
+}
+
+// bindCallsPage reads the page of the calls list requested by the
+// authenticated user and answers with an error if it cannot.
+func bindCallsPage(c *gin.Context) (entity.CallsQuery, bool) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return entity.CallsQuery{}, false
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return entity.CallsQuery{}, false
+	}
+	orgID := c.GetInt64("org_id")
+
+	var filter entity.CallsFilterDTO
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return entity.CallsQuery{}, false
+	}
+
+	after, err := decodeCursor(filter.Cursor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid cursor"})
+		return entity.CallsQuery{}, false
+	}
+
+	query := callsQuery(userID, orgID, filter)
+	query.Limit = filter.Limit
+	query.After = after
+	return query, true
+}
+
+// callsQuery turns the list filters into a query for the calls of userID.
+// Paging is left to the caller.
+func callsQuery(userID, orgID int64, filter entity.CallsFilterDTO) entity.CallsQuery {
+	query := entity.CallsQuery{
+		UserID:      userID,
+		OrgID:       orgID,
+		Status:      filter.Status,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		PhoneNumber: filter.PhoneNumber,
+		ClientName:  filter.ClientName,
+		Priority:    filter.Priority,
+		SLAStatus:   filter.SLA,
+		Tag:         filter.Tag,
+		SortBy:      filter.Sort,
+		SortDesc:    filter.Order != "asc",
+	}
+	if filter.AssignedTo == "me" {
+		query.AssigneeID = userID
+	}
+	return query
+}
+
+// SearchCalls performs a full-text search over the authenticated user's calls.
+//
+// @Summary Search user calls
+// @Description Searches calls by words in the description and client name (russian morphology) and by part of the phone number
+// @Tags calls
+// @Produce json
+// @Param q query string true "Search text"
+// @Param limit query int false "Maximum number of results (1-100, default 20)"
+// @Success 200 {array} entity.CallSearchResult "Ranked search results"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/search [get]
+func (h *CallsHandler) SearchCalls(c *gin.Context) {
 	userIDAny, exists := c.Get("id")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
@@ -96,15 +251,27 @@ func (h *CallsHandler) GetUserCalls(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
 		return
 	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.SearchCallsDTO
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
 
-	calls, err := h.u.GetUserCalls(c.Request.Context(), userID)
+	results, err := h.u.SearchCalls(c.Request.Context(), entity.CallsSearchQuery{
+		UserID: userID,
+		OrgID:  orgID,
+		Text:   input.Q,
+		Limit:  input.Limit,
+	})
 	if err != nil {
-		h.l.Error().Err(err).Msg("Failed to get user calls")
-		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get user calls"})
+		h.l.Error().Err(err).Msg("Failed to search calls")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to search calls"})
 		return
 	}
 
-	c.JSON(http.StatusOK, calls)
+	c.JSON(http.StatusOK, results)
 }
 
 // GetUserCallByID returns a specific call by ID for the authenticated user.
@@ -115,6 +282,7 @@ func (h *CallsHandler) GetUserCalls(c *gin.Context) {
 // @Produce json
 // @Param id path int true "Call ID"
 // @Success 200 {object} entity.CallResponse "Call details"
+// @Header 200 {string} ETag "Current call version"
 // @Failure 400 {object} apierrors.Response "Invalid call ID"
 // @Failure 401 {object} apierrors.Response "Unauthorized"
 // @Failure 404 {object} apierrors.Response "Call not found"
@@ -132,6 +300,7 @@ func (h *CallsHandler) GetUserCallByID(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
 		return
 	}
+	orgID := c.GetInt64("org_id")
 
 	callIDStr := c.Param("id")
 	callID, err := strconv.ParseInt(callIDStr, 10, 64)
@@ -140,7 +309,7 @@ func (h *CallsHandler) GetUserCallByID(c *gin.Context) {
 		return
 	}
 
-	call, err := h.u.GetUserCallByID(c.Request.Context(), callID, userID)
+	call, err := h.u.GetUserCallByID(c.Request.Context(), callID, userID, orgID)
 	if err != nil {
 		if errors.Is(err, usecase.ErrCallNotFound) {
 			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
@@ -153,13 +322,122 @@ func (h *CallsHandler) GetUserCallByID(c *gin.Context) {
 
 	h.l.Info().Interface("call", call).Msg("Call success get")
 
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// UpdateCall partially updates a call guarded by its ETag.
+//
+// @Summary Update call
+// @Description Changes client name, phone number and/or description of a call. The If-Match header must carry the ETag returned by GET /calls/{id}, or * to update whatever the version; weak ETags never match
+// @Tags calls
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param If-Match header string true "ETag of the call version being edited, or *"
+// @Param input body entity.UpdateCallDTO true "Fields to change"
+// @Success 200 {object} entity.CallResponse "Updated call"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 412 {object} apierrors.Response "Call was modified by another request or the ETag is weak"
+// @Failure 428 {object} apierrors.Response "If-Match header is required"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id} [patch]
+func (h *CallsHandler) UpdateCall(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callIDStr := c.Param("id")
+	callID, err := strconv.ParseInt(callIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	ifMatch := c.GetHeader("If-Match")
+	if ifMatch == "" {
+		c.JSON(http.StatusPreconditionRequired, apierrors.Response{Error: "If-Match header is required"})
+		return
+	}
+
+	version, err := parseIfMatch(ifMatch)
+	if err != nil {
+		if errors.Is(err, errWeakETag) {
+			c.JSON(http.StatusPreconditionFailed, apierrors.Response{Error: "Weak ETags do not match in If-Match"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid If-Match header"})
+		return
+	}
+
+	var input entity.UpdateCallDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	if input.ClientName == nil && input.PhoneNumber == nil && input.Description == nil && input.Priority == nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "No fields to update"})
+		return
+	}
+
+	var e164 *string
+	if input.PhoneNumber != nil {
+		number, err := h.phones.Parse(*input.PhoneNumber)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid phone number format"})
+			return
+		}
+		s := number.E164()
+		e164 = &s
+	}
+
+	call, err := h.u.UpdateCall(c.Request.Context(), entity.CallUpdate{
+		ID:          callID,
+		UserID:      userID,
+		OrgID:       orgID,
+		Version:     version,
+		ClientName:  input.ClientName,
+		PhoneNumber: input.PhoneNumber,
+		PhoneE164:   e164,
+		Description: input.Description,
+		Priority:    input.Priority,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCallNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
+		case errors.Is(err, usecase.ErrVersionConflict):
+			c.JSON(http.StatusPreconditionFailed, apierrors.Response{Error: "Call was modified by another request"})
+		default:
+			h.l.Error().Err(err).Msg("Failed to update call")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to update call"})
+		}
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("version", call.Version).Msg("Call success edited")
+
+	c.Header("ETag", formatETag(call.Version))
 	c.JSON(http.StatusOK, call)
 }
 
 // UpdateCallStatus updates the status of a specific call for the authenticated user.
 //
 // @Summary Update call status
-// @Description Updates the status (open or closed) of a specific user call
+// @Description Moves a call to another status of the workflow: new → in_progress → on_hold → resolved → closed, plus reopened
 // @Tags calls
 // @Accept json
 // @Produce json
@@ -169,8 +447,9 @@ func (h *CallsHandler) GetUserCallByID(c *gin.Context) {
 // @Failure 400 {object} apierrors.Response "Invalid input or status value"
 // @Failure 401 {object} apierrors.Response "Unauthorized"
 // @Failure 404 {object} apierrors.Response "Call not found or does not belong to user"
+// @Failure 409 {object} apierrors.TransitionResponse "Transition is not allowed"
 // @Failure 500 {object} apierrors.Response "Internal server error"
-// @Router /calls/{id}/status [put]
+// @Router /calls/{id}/status [patch]
 func (h *CallsHandler) UpdateCallStatus(c *gin.Context) {
 	userIDAny, exists := c.Get("id")
 	if !exists {
@@ -183,6 +462,7 @@ func (h *CallsHandler) UpdateCallStatus(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
 		return
 	}
+	orgID := c.GetInt64("org_id")
 
 	callIDStr := c.Param("id")
 	callID, err := strconv.ParseInt(callIDStr, 10, 64)
@@ -198,16 +478,29 @@ func (h *CallsHandler) UpdateCallStatus(c *gin.Context) {
 		return
 	}
 
-	if input.Status != "открыта" && input.Status != "закрыта" {
+	if !usecase.IsKnownStatus(input.Status) {
 		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid status value"})
 		return
 	}
 
-	if err := h.u.UpdateCallStatus(c.Request.Context(), callID, userID, input.Status); err != nil {
+	if err := h.u.UpdateCallStatus(c.Request.Context(), callID, userID, orgID, input.Status); err != nil {
 		if errors.Is(err, usecase.ErrCallNotFound) {
 			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
 			return
 		}
+		var transitionErr *usecase.TransitionError
+		if errors.As(err, &transitionErr) {
+			c.JSON(http.StatusConflict, apierrors.TransitionResponse{
+				Error:           "Status transition is not allowed",
+				CurrentStatus:   transitionErr.From,
+				AllowedStatuses: transitionErr.Allowed,
+			})
+			return
+		}
+		if errors.Is(err, usecase.ErrStatusChanged) {
+			c.JSON(http.StatusConflict, apierrors.Response{Error: "Call status was changed by another request"})
+			return
+		}
 		h.l.Error().Err(err).Msg("Failed to update call status")
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to update call status"})
 		return
@@ -218,10 +511,10 @@ func (h *CallsHandler) UpdateCallStatus(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
-// DeleteCall deletes a specific call for the authenticated user.
+// DeleteCall moves a specific call of the authenticated user to the trash.
 //
 // @Summary Delete call
-// @Description Deletes a call belonging to the authenticated user by its ID
+// @Description Moves a call belonging to the authenticated user to the trash. It can be restored until the retention period expires
 // @Tags calls
 // @Produce json
 // @Param id path int true "Call ID"
@@ -243,6 +536,7 @@ func (h *CallsHandler) DeleteCall(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
 		return
 	}
+	orgID := c.GetInt64("org_id")
 
 	callIDStr := c.Param("id")
 	callID, err := strconv.ParseInt(callIDStr, 10, 64)
@@ -251,7 +545,7 @@ func (h *CallsHandler) DeleteCall(c *gin.Context) {
 		return
 	}
 
-	if err := h.u.DeleteCall(c.Request.Context(), callID, userID); err != nil {
+	if err := h.u.DeleteCall(c.Request.Context(), callID, userID, orgID); err != nil {
 		if errors.Is(err, usecase.ErrCallNotFound) {
 			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
 			return
@@ -266,7 +560,101 @@ func (h *CallsHandler) DeleteCall(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
-func ValidatePhoneNumber(phone string) bool {
-	re := regexp.MustCompile(`^(\+?\d{1,3}|\d)?[\d\-]{7,15}$`)
-	return re.MatchString(phone)
+// RestoreCall takes a deleted call back out of the trash.
+//
+// @Summary Restore call
+// @Description Restores a deleted call belonging to the authenticated user
+// @Tags calls
+// @Produce json
+// @Param id path int true "Call ID"
+// @Success 200 {object} entity.CallResponse "Restored call"
+// @Header 200 {string} ETag "New call version"
+// @Failure 400 {object} apierrors.Response "Invalid call ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found in trash"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/restore [post]
+func (h *CallsHandler) RestoreCall(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	call, err := h.u.RestoreCall(c.Request.Context(), callID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found in trash"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to restore call")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to restore call"})
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Msg("Call success restored")
+
+	c.Header("ETag", formatETag(call.Version))
+	c.JSON(http.StatusOK, call)
+}
+
+// GetCallHistory returns the change history of a call.
+//
+// @Summary Get call history
+// @Description Returns every field-level change of a call (creation, edits, status changes, assignment, deletion and restore) in chronological order
+// @Tags calls
+// @Produce json
+// @Param id path int true "Call ID"
+// @Success 200 {array} entity.CallEvent "Call events"
+// @Failure 400 {object} apierrors.Response "Invalid call ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/history [get]
+func (h *CallsHandler) GetCallHistory(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callIDStr := c.Param("id")
+	callID, err := strconv.ParseInt(callIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	events, err := h.u.GetCallHistory(c.Request.Context(), callID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to get call history")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get call history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
 }
@@ -2,10 +2,12 @@ package controller_test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -21,35 +23,12 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-func TestValidatePhoneNumber(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected bool
-	}{
-		{"Valid with plus and country code", "+71234567890", true},
-		{"Valid without plus", "81234567890", true},
-		{"Valid with dashes", "812-345-6789", true},
-		{"Too short", "12345", false},
-		{"Too long", "12345678901234567890", false},
-		{"Letters inside", "123ABC7890", false},
-		{"Empty string", "", false},
-		{"Plus only", "+", false},
-		{"Country code only", "+7", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := controller.ValidatePhoneNumber(tt.input)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestSaveCall(t *testing.T) {
 	tests := []struct {
 		name             string
 		input            entity.CallDTO
+		query            string
+		force            bool
 		mockSaveCallErr  error
 		expectedStatus   int
 		expectedResponse apierrors.Response
@@ -70,6 +49,20 @@ func TestSaveCall(t *testing.T) {
 			},
 			shouldCallMock: true,
 		},
+		{
+			name: "National phone number format",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "8 (987) 654-32-11",
+				Description: "Test call",
+			},
+			mockSaveCallErr: nil,
+			expectedStatus:  http.StatusCreated,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
 		{
 			name: "Invalid phone number format",
 			input: entity.CallDTO{
@@ -120,6 +113,57 @@ func TestSaveCall(t *testing.T) {
 			},
 			shouldCallMock: true,
 		},
+		{
+			name: "Duplicate call",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				Description: "Test call",
+			},
+			mockSaveCallErr: &usecase.DuplicateCallError{CallID: 42},
+			expectedStatus:  http.StatusConflict,
+			expectedResponse: apierrors.Response{
+				Error: "Call duplicates an open call",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name: "Forced save of duplicate",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				Description: "Test call",
+			},
+			query:           "?force=true",
+			force:           true,
+			mockSaveCallErr: nil,
+			expectedStatus:  http.StatusCreated,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name: "Invalid force parameter",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				Description: "Test call",
+			},
+			query:           "?force=maybe",
+			mockSaveCallErr: nil,
+			expectedStatus:  http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
 		{
 			name: "Empty client name",
 			input: entity.CallDTO{
@@ -137,6 +181,39 @@ func TestSaveCall(t *testing.T) {
 			},
 			shouldCallMock: false,
 		},
+		{
+			name: "Successful save with priority",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				Description: "Test call",
+				Priority:    entity.PriorityCritical,
+			},
+			mockSaveCallErr: nil,
+			expectedStatus:  http.StatusCreated,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name: "Unknown priority",
+			input: entity.CallDTO{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				Description: "Test call",
+				Priority:    "urgent",
+			},
+			mockSaveCallErr: nil,
+			expectedStatus:  http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid request format",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
 		{
 			name: "Empty description",
 			input: entity.CallDTO{
@@ -160,8 +237,10 @@ func TestSaveCall(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := mocks.NewMockUseCase(t)
 			if tt.shouldCallMock {
-				mockUseCase.On("SaveCall", mock.Anything, mock.AnythingOfType("entity.Call")).
-					Return(tt.mockSaveCallErr)
+				mockUseCase.On("SaveCall", mock.Anything, mock.MatchedBy(func(call entity.Call) bool {
+					return call.UserID == 123 && call.Priority == tt.input.Priority && call.PhoneE164 == "+79876543211"
+				}), tt.force).
+					Return(int64(1), tt.mockSaveCallErr)
 			}
 
 			requestBody, err := json.Marshal(tt.input)
@@ -170,7 +249,7 @@ func TestSaveCall(t *testing.T) {
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			tt.setupContext(c)
-			c.Request = httptest.NewRequest("POST", "/calls", bytes.NewBuffer(requestBody))
+			c.Request = httptest.NewRequest("POST", "/calls"+tt.query, bytes.NewBuffer(requestBody))
 
 			handler := controller.New(mockUseCase, zerolog.Nop())
 
@@ -198,44 +277,106 @@ func TestSaveCall(t *testing.T) {
 }
 
 func TestGetUserCalls(t *testing.T) {
+	nextCursor := &entity.CallsCursor{SortBy: entity.SortByCreatedAt, Value: "2025-01-01T00:00:00Z", ID: 1}
+
 	tests := []struct {
 		name             string
-		mockGetCallsRes  []entity.CallResponse
+		query            string
+		mockGetCallsRes  *entity.CallsPage
 		mockGetCallsErr  error
 		expectedStatus   int
 		expectedResponse any
+		expectedNext     bool
+		expectedAssignee int64
+		expectedSLA      string
 		setupContext     func(c *gin.Context)
 		shouldCallMock   bool
 	}{
 		{
 			name: "Successful retrieval",
-			mockGetCallsRes: []entity.CallResponse{
-				{
-					ID:          1,
-					ClientName:  "John Doe",
-					PhoneNumber: "+79876543211",
-					Description: "Test call",
-					Status:      "completed",
-					CreatedAt:   time.Now(),
+			mockGetCallsRes: &entity.CallsPage{
+				Items: []entity.CallResponse{
+					{
+						ID:          1,
+						ClientName:  "John Doe",
+						PhoneNumber: "+79876543211",
+						Description: "Test call",
+						Status:      entity.StatusClosed,
+						CreatedAt:   time.Now(),
+					},
 				},
 			},
 			mockGetCallsErr: nil,
 			expectedStatus:  http.StatusOK,
-			expectedResponse: []entity.CallResponse{
-				{
-					ID:          1,
-					ClientName:  "John Doe",
-					PhoneNumber: "+79876543211",
-					Description: "Test call",
-					Status:      "completed",
-					CreatedAt:   time.Now(),
-				},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:  "Successful retrieval with next page",
+			query: "?limit=1&status=new&sort=created_at&order=desc",
+			mockGetCallsRes: &entity.CallsPage{
+				Items: []entity.CallResponse{{ID: 1, ClientName: "John Doe"}},
+				Next:  nextCursor,
+			},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:  "Only calls assigned to me",
+			query: "?assigned_to=me",
+			mockGetCallsRes: &entity.CallsPage{
+				Items: []entity.CallResponse{{ID: 2, ClientName: "Jane Doe"}},
+			},
+			expectedStatus:   http.StatusOK,
+			expectedAssignee: 123,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:  "Breached SLA only",
+			query: "?sla=breached",
+			mockGetCallsRes: &entity.CallsPage{
+				Items: []entity.CallResponse{{ID: 3, ClientName: "John Doe", SLAStatus: entity.SLABreached}},
 			},
+			expectedStatus: http.StatusOK,
+			expectedSLA:    entity.SLABreached,
 			setupContext: func(c *gin.Context) {
 				c.Set("id", int64(123))
 			},
 			shouldCallMock: true,
 		},
+		{
+			name:           "Unknown sla value",
+			query:          "?sla=late",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:           "Unknown assigned_to value",
+			query:          "?assigned_to=someone",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
 		{
 			name:            "Unauthorized (missing user ID)",
 			mockGetCallsErr: nil,
@@ -258,6 +399,55 @@ func TestGetUserCalls(t *testing.T) {
 			},
 			shouldCallMock: false,
 		},
+		{
+			name:           "Limit out of range",
+			query:          "?limit=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:           "Unknown sort field",
+			query:          "?sort=description",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:           "Malformed cursor",
+			query:          "?cursor=not-a-cursor",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid cursor",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:            "Cursor from another sort order",
+			query:           "?sort=client_name&cursor=" + encodeTestCursor(t, nextCursor),
+			mockGetCallsErr: usecase.ErrInvalidCursor,
+			expectedStatus:  http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid cursor",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
 		{
 			name:            "Failed to get calls (internal error)",
 			mockGetCallsErr: errors.New("db failure"),
@@ -276,14 +466,16 @@ func TestGetUserCalls(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := mocks.NewMockUseCase(t)
 			if tt.shouldCallMock {
-				mockUseCase.On("GetUserCalls", mock.Anything, int64(123)).
+				mockUseCase.On("GetUserCalls", mock.Anything, mock.MatchedBy(func(q entity.CallsQuery) bool {
+					return q.UserID == 123 && q.AssigneeID == tt.expectedAssignee && q.SLAStatus == tt.expectedSLA
+				})).
 					Return(tt.mockGetCallsRes, tt.mockGetCallsErr)
 			}
 
 			w := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(w)
 			tt.setupContext(c)
-			c.Request = httptest.NewRequest("GET", "/calls", nil)
+			c.Request = httptest.NewRequest("GET", "/calls"+tt.query, nil)
 
 			handler := controller.New(mockUseCase, zerolog.Nop())
 
@@ -293,10 +485,11 @@ func TestGetUserCalls(t *testing.T) {
 
 			var responseBody []byte = w.Body.Bytes()
 			if tt.expectedStatus == http.StatusOK {
-				var responses []entity.CallResponse
-				err := json.Unmarshal(responseBody, &responses)
+				var response entity.CallsListResponse
+				err := json.Unmarshal(responseBody, &response)
 				assert.NoError(t, err)
-				assert.Equal(t, len(tt.mockGetCallsRes), len(responses))
+				assert.Equal(t, len(tt.mockGetCallsRes.Items), len(response.Items))
+				assert.Equal(t, tt.expectedNext, response.NextCursor != "")
 			} else {
 				var response apierrors.Response
 				err := json.Unmarshal(responseBody, &response)
@@ -313,6 +506,104 @@ func TestGetUserCalls(t *testing.T) {
 	}
 }
 
+func encodeTestCursor(t *testing.T, cursor *entity.CallsCursor) string {
+	t.Helper()
+	data, err := json.Marshal(cursor)
+	assert.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func TestSearchCalls(t *testing.T) {
+	searchQuery := "?q=" + url.QueryEscape("интернет")
+
+	tests := []struct {
+		name             string
+		query            string
+		mockResults      []entity.CallSearchResult
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:  "Successful search",
+			query: searchQuery,
+			mockResults: []entity.CallSearchResult{
+				{
+					CallResponse: entity.CallResponse{ID: 1, Description: "Не работает интернет"},
+					Rank:         0.6,
+					Highlight:    entity.CallHighlight{Description: "Не работает <mark>интернет</mark>"},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			setupContext:   func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock: true,
+		},
+		{
+			name:             "Missing query text",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			query:            searchQuery,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			query:            searchQuery,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to search calls"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("SearchCalls", mock.Anything, entity.CallsSearchQuery{UserID: 123, Text: "интернет"}).
+					Return(tt.mockResults, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Request = httptest.NewRequest("GET", "/calls/search"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.SearchCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response []entity.CallSearchResult
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockResults, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "SearchCalls")
+			}
+		})
+	}
+}
+
 func TestUpdateCallStatus(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -330,19 +621,19 @@ func TestUpdateCallStatus(t *testing.T) {
 			name:        "Successful update",
 			callIDParam: "1",
 			inputBody: entity.UpdateCallStatusDTO{
-				Status: "открыта",
+				Status: entity.StatusInProgress,
 			},
 			mockUpdateErr:      nil,
 			expectedStatus:     http.StatusNoContent,
 			setupContext:       func(c *gin.Context) { c.Set("id", int64(123)) },
 			shouldCallMock:     true,
 			expectedCallID:     1,
-			expectedStatusText: "открыта",
+			expectedStatusText: entity.StatusInProgress,
 		},
 		{
 			name:             "Unauthorized (missing user ID)",
 			callIDParam:      "1",
-			inputBody:        entity.UpdateCallStatusDTO{Status: "открыта"},
+			inputBody:        entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
 			expectedStatus:   http.StatusUnauthorized,
 			expectedResponse: apierrors.Response{Error: "Unauthorized"},
 			setupContext:     func(c *gin.Context) {}, // no id
@@ -351,7 +642,7 @@ func TestUpdateCallStatus(t *testing.T) {
 		{
 			name:             "Invalid user ID type",
 			callIDParam:      "1",
-			inputBody:        entity.UpdateCallStatusDTO{Status: "открыта"},
+			inputBody:        entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
 			expectedStatus:   http.StatusInternalServerError,
 			expectedResponse: apierrors.Response{Error: "Invalid user ID in context"},
 			setupContext:     func(c *gin.Context) { c.Set("id", "not-an-int64") },
@@ -360,7 +651,7 @@ func TestUpdateCallStatus(t *testing.T) {
 		{
 			name:             "Invalid call ID param",
 			callIDParam:      "abc",
-			inputBody:        entity.UpdateCallStatusDTO{Status: "открыта"},
+			inputBody:        entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
 			expectedStatus:   http.StatusBadRequest,
 			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
 			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
@@ -387,26 +678,49 @@ func TestUpdateCallStatus(t *testing.T) {
 		{
 			name:               "Call not found",
 			callIDParam:        "1",
-			inputBody:          entity.UpdateCallStatusDTO{Status: "открыта"},
+			inputBody:          entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
 			mockUpdateErr:      usecase.ErrCallNotFound,
 			expectedStatus:     http.StatusNotFound,
 			expectedResponse:   apierrors.Response{Error: "Call not found or does not belong to user"},
 			setupContext:       func(c *gin.Context) { c.Set("id", int64(123)) },
 			shouldCallMock:     true,
 			expectedCallID:     1,
-			expectedStatusText: "открыта",
+			expectedStatusText: entity.StatusInProgress,
+		},
+		{
+			name:               "Transition not allowed",
+			callIDParam:        "1",
+			inputBody:          entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
+			mockUpdateErr:      &usecase.TransitionError{From: entity.StatusClosed, To: entity.StatusInProgress, Allowed: []string{entity.StatusReopened}},
+			expectedStatus:     http.StatusConflict,
+			setupContext:       func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:     true,
+			expectedCallID:     1,
+			expectedStatusText: entity.StatusInProgress,
+		},
+		{
+			name:               "Status changed concurrently",
+			callIDParam:        "1",
+			inputBody:          entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
+			mockUpdateErr:      usecase.ErrStatusChanged,
+			expectedStatus:     http.StatusConflict,
+			expectedResponse:   apierrors.Response{Error: "Call status was changed by another request"},
+			setupContext:       func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:     true,
+			expectedCallID:     1,
+			expectedStatusText: entity.StatusInProgress,
 		},
 		{
 			name:               "Internal server error",
 			callIDParam:        "1",
-			inputBody:          entity.UpdateCallStatusDTO{Status: "открыта"},
+			inputBody:          entity.UpdateCallStatusDTO{Status: entity.StatusInProgress},
 			mockUpdateErr:      errors.New("db error"),
 			expectedStatus:     http.StatusInternalServerError,
 			expectedResponse:   apierrors.Response{Error: "Failed to update call status"},
 			setupContext:       func(c *gin.Context) { c.Set("id", int64(123)) },
 			shouldCallMock:     true,
 			expectedCallID:     1,
-			expectedStatusText: "открыта",
+			expectedStatusText: entity.StatusInProgress,
 		},
 	}
 
@@ -414,7 +728,7 @@ func TestUpdateCallStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := mocks.NewMockUseCase(t)
 			if tt.shouldCallMock {
-				mockUseCase.On("UpdateCallStatus", mock.Anything, tt.expectedCallID, int64(123), tt.expectedStatusText).
+				mockUseCase.On("UpdateCallStatus", mock.Anything, tt.expectedCallID, int64(123), int64(0), tt.expectedStatusText).
 					Return(tt.mockUpdateErr)
 			}
 
@@ -439,7 +753,17 @@ func TestUpdateCallStatus(t *testing.T) {
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
-			if tt.expectedStatus != http.StatusNoContent {
+			var transitionErr *usecase.TransitionError
+			if errors.As(tt.mockUpdateErr, &transitionErr) {
+				var response apierrors.TransitionResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, apierrors.TransitionResponse{
+					Error:           "Status transition is not allowed",
+					CurrentStatus:   entity.StatusClosed,
+					AllowedStatuses: []string{entity.StatusReopened},
+				}, response)
+			} else if tt.expectedStatus != http.StatusNoContent {
 				var response apierrors.Response
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
@@ -473,12 +797,13 @@ func TestGetUserCallByID(t *testing.T) {
 			name:        "Successful fetch",
 			callIDParam: "1",
 			mockGetCall: &entity.CallResponse{
-				ID:     1,
-				Status: "открыта",
+				ID:      1,
+				Status:  entity.StatusNew,
+				Version: 3,
 			},
 			mockGetCallErr:   nil,
 			expectedStatus:   http.StatusOK,
-			expectedResponse: entity.CallResponse{ID: 1, Status: "открыта"},
+			expectedResponse: entity.CallResponse{ID: 1, Status: entity.StatusNew},
 			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
 			shouldCallMock:   true,
 			expectedCallID:   1,
@@ -533,7 +858,7 @@ func TestGetUserCallByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := mocks.NewMockUseCase(t)
 			if tt.shouldCallMock {
-				mockUseCase.On("GetUserCallByID", mock.Anything, tt.expectedCallID, int64(123)).
+				mockUseCase.On("GetUserCallByID", mock.Anything, tt.expectedCallID, int64(123), int64(0)).
 					Return(tt.mockGetCall, tt.mockGetCallErr)
 			}
 
@@ -554,6 +879,7 @@ func TestGetUserCallByID(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Equal(t, *tt.mockGetCall, response)
+				assert.Equal(t, `"3"`, w.Header().Get("ETag"))
 			} else {
 				var response apierrors.Response
 				err := json.Unmarshal(w.Body.Bytes(), &response)
@@ -570,16 +896,181 @@ func TestGetUserCallByID(t *testing.T) {
 	}
 }
 
-func TestDeleteCall(t *testing.T) {
+func TestUpdateCall(t *testing.T) {
+	newName := "Jane Doe"
+	badPhone := "invalid-phone"
+
 	tests := []struct {
 		name             string
 		callIDParam      string
-		mockDeleteErr    error
+		ifMatch          string
+		anyVersion       bool
+		body             string
+		mockCall         *entity.CallResponse
+		mockErr          error
 		expectedStatus   int
-		expectedResponse any
-		setupContext     func(c *gin.Context)
+		expectedResponse apierrors.Response
 		shouldCallMock   bool
-		expectedCallID   int64
+	}{
+		{
+			name:           "Successful update",
+			callIDParam:    "1",
+			ifMatch:        `"2"`,
+			body:           `{"client_name":"Jane Doe"}`,
+			mockCall:       &entity.CallResponse{ID: 1, ClientName: newName, Version: 3},
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Weak ETag does not match",
+			callIDParam:      "1",
+			ifMatch:          `W/"2"`,
+			body:             `{"client_name":"Jane Doe"}`,
+			expectedStatus:   http.StatusPreconditionFailed,
+			expectedResponse: apierrors.Response{Error: "Weak ETags do not match in If-Match"},
+		},
+		{
+			name:           "Any version",
+			callIDParam:    "1",
+			ifMatch:        "*",
+			anyVersion:     true,
+			body:           `{"client_name":"Jane Doe"}`,
+			mockCall:       &entity.CallResponse{ID: 1, ClientName: newName, Version: 3},
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Missing If-Match",
+			callIDParam:      "1",
+			body:             `{"client_name":"Jane Doe"}`,
+			expectedStatus:   http.StatusPreconditionRequired,
+			expectedResponse: apierrors.Response{Error: "If-Match header is required"},
+		},
+		{
+			name:             "Malformed If-Match",
+			callIDParam:      "1",
+			ifMatch:          "2",
+			body:             `{"client_name":"Jane Doe"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid If-Match header"},
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			ifMatch:          `"2"`,
+			body:             `{"client_name":"Jane Doe"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+		},
+		{
+			name:             "Empty update",
+			callIDParam:      "1",
+			ifMatch:          `"2"`,
+			body:             `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "No fields to update"},
+		},
+		{
+			name:             "Invalid phone number",
+			callIDParam:      "1",
+			ifMatch:          `"2"`,
+			body:             `{"phone_number":"` + badPhone + `"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid phone number format"},
+		},
+		{
+			name:             "Lost update",
+			callIDParam:      "1",
+			ifMatch:          `"2"`,
+			body:             `{"client_name":"Jane Doe"}`,
+			mockErr:          usecase.ErrVersionConflict,
+			expectedStatus:   http.StatusPreconditionFailed,
+			expectedResponse: apierrors.Response{Error: "Call was modified by another request"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			ifMatch:          `"2"`,
+			body:             `{"client_name":"Jane Doe"}`,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			ifMatch:          `"2"`,
+			body:             `{"client_name":"Jane Doe"}`,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to update call"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				version := int64(2)
+				if tt.anyVersion {
+					version = 0
+				}
+				mockUseCase.On("UpdateCall", mock.Anything, entity.CallUpdate{
+					ID:         1,
+					UserID:     123,
+					Version:    version,
+					ClientName: &newName,
+				}).Return(tt.mockCall, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("PATCH", "/calls/"+tt.callIDParam, bytes.NewBufferString(tt.body))
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.UpdateCall(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.mockCall, response)
+				assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "UpdateCall")
+			}
+		})
+	}
+}
+
+func TestDeleteCall(t *testing.T) {
+	tests := []struct {
+		name             string
+		callIDParam      string
+		mockDeleteErr    error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+		expectedCallID   int64
 	}{
 		{
 			name:           "Successful delete",
@@ -640,7 +1131,7 @@ func TestDeleteCall(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUseCase := mocks.NewMockUseCase(t)
 			if tt.shouldCallMock {
-				mockUseCase.On("DeleteCall", mock.Anything, tt.expectedCallID, int64(123)).
+				mockUseCase.On("DeleteCall", mock.Anything, tt.expectedCallID, int64(123), int64(0)).
 					Return(tt.mockDeleteErr)
 			}
 
@@ -673,3 +1164,293 @@ func TestDeleteCall(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCallHistory(t *testing.T) {
+	oldStatus, newStatus := entity.StatusNew, entity.StatusInProgress
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		mockEvents       []entity.CallEvent
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:        "Successful fetch",
+			callIDParam: "1",
+			mockEvents: []entity.CallEvent{
+				{ID: 1, CallID: 1, UserID: 123, Type: entity.EventCreated, Field: "status", NewValue: &oldStatus},
+				{ID: 2, CallID: 1, UserID: 123, Type: entity.EventStatusChanged, Field: "status", OldValue: &oldStatus, NewValue: &newStatus},
+			},
+			expectedStatus: http.StatusOK,
+			setupContext:   func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock: true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			callIDParam:      "1",
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to get call history"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetCallHistory", mock.Anything, int64(1), int64(123), int64(0)).
+					Return(tt.mockEvents, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("GET", "/calls/"+tt.callIDParam+"/history", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetCallHistory(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response []entity.CallEvent
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockEvents, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetCallHistory")
+			}
+		})
+	}
+}
+
+func TestGetTrash(t *testing.T) {
+	deletedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		query            string
+		mockGetCallsRes  *entity.CallsPage
+		mockGetCallsErr  error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name: "Successful retrieval",
+			mockGetCallsRes: &entity.CallsPage{
+				Items: []entity.CallResponse{{ID: 1, ClientName: "John Doe", DeletedAt: &deletedAt}},
+			},
+			expectedStatus: http.StatusOK,
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Limit out of range",
+			query:          "?limit=1000",
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: apierrors.Response{
+				Error: "Invalid query parameters",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: false,
+		},
+		{
+			name:            "Failed to get calls (internal error)",
+			mockGetCallsErr: errors.New("db failure"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{
+				Error: "Failed to get user calls",
+			},
+			setupContext: func(c *gin.Context) {
+				c.Set("id", int64(123))
+			},
+			shouldCallMock: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetUserCalls", mock.Anything, mock.MatchedBy(func(q entity.CallsQuery) bool {
+					return q.UserID == 123 && q.Deleted
+				})).
+					Return(tt.mockGetCallsRes, tt.mockGetCallsErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Request = httptest.NewRequest("GET", "/calls/trash"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetTrash(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.CallsListResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockGetCallsRes.Items, response.Items)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetUserCalls")
+			}
+		})
+	}
+}
+
+func TestRestoreCall(t *testing.T) {
+	restored := &entity.CallResponse{
+		ID:         1,
+		ClientName: "John Doe",
+		Status:     entity.StatusNew,
+		Version:    4,
+	}
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		mockCall         *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		expectedETag     string
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful restore",
+			callIDParam:      "1",
+			mockCall:         restored,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: *restored,
+			expectedETag:     `"4"`,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			callIDParam:      "1",
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not in trash",
+			callIDParam:      "1",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found in trash"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to restore call"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("RestoreCall", mock.Anything, int64(1), int64(123), int64(0)).
+					Return(tt.mockCall, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callIDParam+"/restore", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.RestoreCall(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "RestoreCall")
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetClients returns a page of clients of the authenticated user's calls.
+//
+// @Summary Get clients
+// @Description Retrieves a page of clients that have active calls created by or assigned to the authenticated user, newest first. Clients are identified by phone number
+// @Tags clients
+// @Produce json
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param q query string false "Part of the client name or phone number"
+// @Success 200 {object} entity.ClientsListResponse "Page of clients"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /clients [get]
+func (h *CallsHandler) GetClients(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var filter entity.ClientsFilterDTO
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	after, err := decodeClientsCursor(filter.Cursor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid cursor"})
+		return
+	}
+
+	page, err := h.u.GetClients(c.Request.Context(), entity.ClientsQuery{
+		UserID: userID,
+		OrgID:  orgID,
+		Limit:  filter.Limit,
+		After:  after,
+		Q:      filter.Q,
+	})
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get clients")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get clients"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.ClientsListResponse{
+		Items:      page.Items,
+		NextCursor: encodeCursor(page.Next),
+	})
+}
+
+// GetClient returns a client of the authenticated user's calls.
+//
+// @Summary Get client
+// @Description Retrieves a client with the number of its active calls visible to the authenticated user
+// @Tags clients
+// @Produce json
+// @Param id path int true "Client ID"
+// @Success 200 {object} entity.Client "Client"
+// @Failure 400 {object} apierrors.Response "Invalid client ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Client not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /clients/{id} [get]
+func (h *CallsHandler) GetClient(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	clientID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid client ID"})
+		return
+	}
+
+	client, err := h.u.GetClient(c.Request.Context(), clientID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrClientNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Client not found"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to get client")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get client"})
+		return
+	}
+
+	c.JSON(http.StatusOK, client)
+}
+
+// GetClientCalls returns a page of the calls of a client.
+//
+// @Summary Get client calls
+// @Description Retrieves a page of active calls of a client created by or assigned to the authenticated user. Accepts the same parameters as GET /calls
+// @Tags clients
+// @Produce json
+// @Param id path int true "Client ID"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Param cursor query string false "Cursor from the previous page"
+// @Param status query string false "Filter by status"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param tag query string false "Filter by tag name (case-insensitive)"
+// @Param assigned_to query string false "Only calls assigned to the authenticated user" Enums(me)
+// @Param priority query string false "Filter by priority" Enums(low, normal, high, critical)
+// @Param sla query string false "Filter by SLA state" Enums(ok, at_risk, breached)
+// @Param sort query string false "Sort field" Enums(created_at, client_name, status, id)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {object} entity.CallsListResponse "Page of calls"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Client not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /clients/{id}/calls [get]
+func (h *CallsHandler) GetClientCalls(c *gin.Context) {
+	query, ok := bindCallsPage(c)
+	if !ok {
+		return
+	}
+
+	clientID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid client ID"})
+		return
+	}
+	query.ClientID = clientID
+
+	page, err := h.u.GetClientCalls(c.Request.Context(), query)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrClientNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Client not found"})
+		case errors.Is(err, usecase.ErrInvalidCursor):
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid cursor"})
+		default:
+			h.l.Error().Err(err).Msg("Failed to get client calls")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get client calls"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.CallsListResponse{
+		Items:      page.Items,
+		NextCursor: encodeCursor(page.Next),
+	})
+}
@@ -0,0 +1,280 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetClients(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	client := entity.Client{ID: 5, Name: "John Doe", PhoneNumber: "79876543211", CreatedAt: createdAt, CallsCount: 2, LastCallAt: createdAt}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedQuery    entity.ClientsQuery
+		mockReturn       *entity.ClientsPage
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful retrieval with next page",
+			query:            "?limit=1&q=doe",
+			expectedQuery:    entity.ClientsQuery{UserID: 123, Limit: 1, Q: "doe"},
+			mockReturn:       &entity.ClientsPage{Items: []entity.Client{client}, Next: &entity.ClientsCursor{ID: 5}},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.ClientsListResponse{Items: []entity.Client{client}, NextCursor: "eyJpZCI6NX0"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Next page",
+			query:            "?cursor=eyJpZCI6NX0",
+			expectedQuery:    entity.ClientsQuery{UserID: 123, After: &entity.ClientsCursor{ID: 5}},
+			mockReturn:       &entity.ClientsPage{Items: []entity.Client{}},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.ClientsListResponse{Items: []entity.Client{}},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid cursor",
+			query:            "?cursor=bad",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid cursor"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid limit",
+			query:            "?limit=1000",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			query:            "",
+			expectedQuery:    entity.ClientsQuery{UserID: 123},
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to get clients"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetClients", mock.Anything, tt.expectedQuery).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("GET", "/clients"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetClients(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.ClientsListResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetClients")
+			}
+		})
+	}
+}
+
+func TestGetClient(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	client := entity.Client{ID: 5, Name: "John Doe", PhoneNumber: "79876543211", CreatedAt: createdAt, CallsCount: 1, LastCallAt: createdAt}
+
+	tests := []struct {
+		name             string
+		clientID         string
+		mockReturn       *entity.Client
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful retrieval",
+			clientID:         "5",
+			mockReturn:       &client,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: client,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Client not found",
+			clientID:         "5",
+			mockErr:          usecase.ErrClientNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Client not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid client ID",
+			clientID:         "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid client ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetClient", mock.Anything, int64(5), int64(123), int64(0)).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.clientID}}
+			c.Request = httptest.NewRequest("GET", "/clients/"+tt.clientID, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetClient(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.Client
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetClient")
+			}
+		})
+	}
+}
+
+func TestGetClientCalls(t *testing.T) {
+	clientID := int64(5)
+	calls := []entity.CallResponse{{ID: 1, ClientName: "John Doe", PhoneNumber: "+79876543211", ClientID: &clientID}}
+
+	tests := []struct {
+		name             string
+		clientID         string
+		query            string
+		expectedQuery    entity.CallsQuery
+		mockReturn       *entity.CallsPage
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful retrieval",
+			clientID:         "5",
+			query:            "?status=new&order=asc",
+			expectedQuery:    entity.CallsQuery{UserID: 123, ClientID: 5, Status: "new"},
+			mockReturn:       &entity.CallsPage{Items: calls},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.CallsListResponse{Items: calls},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Client not found",
+			clientID:         "5",
+			expectedQuery:    entity.CallsQuery{UserID: 123, ClientID: 5, SortDesc: true},
+			mockErr:          usecase.ErrClientNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Client not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid client ID",
+			clientID:         "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid client ID"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid query parameters",
+			clientID:         "5",
+			query:            "?sort=phone_number",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetClientCalls", mock.Anything, tt.expectedQuery).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.clientID}}
+			c.Request = httptest.NewRequest("GET", "/clients/"+tt.clientID+"/calls"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetClientCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallsListResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetClientCalls")
+			}
+		})
+	}
+}
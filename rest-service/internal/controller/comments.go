@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddComment adds a comment or an internal note to a call.
+//
+// @Summary Add comment
+// @Description Adds a comment to a call belonging to the authenticated user. Internal comments are operator notes hidden from the client
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param input body entity.CommentDTO true "Comment"
+// @Success 201 {object} entity.Comment "Created comment"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/comments [post]
+func (h *CallsHandler) AddComment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.CommentDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	comment, err := h.u.AddComment(c.Request.Context(), userID, orgID, entity.Comment{
+		CallID:     callID,
+		Body:       input.Body,
+		IsInternal: input.IsInternal,
+	})
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to add comment")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to add comment"})
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("commentID", comment.ID).Msg("Comment success save")
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// GetComments returns comments of a call.
+//
+// @Summary Get comments
+// @Description Returns comments of a call belonging to the authenticated user in chronological order
+// @Tags comments
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param internal query bool false "Only internal notes (true) or only client-visible comments (false)"
+// @Success 200 {array} entity.Comment "Comments"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/comments [get]
+func (h *CallsHandler) GetComments(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var filter entity.CommentsFilterDTO
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	comments, err := h.u.GetComments(c.Request.Context(), callID, userID, orgID, filter.Internal)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to get comments")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get comments"})
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+// UpdateComment edits a comment written by the authenticated user.
+//
+// @Summary Update comment
+// @Description Changes the text and/or the internal flag of a comment. Only the author may edit a comment
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param commentID path int true "Comment ID"
+// @Param input body entity.UpdateCommentDTO true "Fields to change"
+// @Success 200 {object} entity.Comment "Updated comment"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Comment belongs to another user"
+// @Failure 404 {object} apierrors.Response "Call or comment not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/comments/{commentID} [patch]
+func (h *CallsHandler) UpdateComment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	commentID, err := strconv.ParseInt(c.Param("commentID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid comment ID"})
+		return
+	}
+
+	var input entity.UpdateCommentDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	if input.Body == nil && input.IsInternal == nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "No fields to update"})
+		return
+	}
+
+	comment, err := h.u.UpdateComment(c.Request.Context(), entity.CommentUpdate{
+		ID:         commentID,
+		CallID:     callID,
+		AuthorID:   userID,
+		OrgID:      orgID,
+		Body:       input.Body,
+		IsInternal: input.IsInternal,
+	})
+	if err != nil {
+		h.commentError(c, err, "Failed to update comment")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("commentID", commentID).Msg("Comment success update")
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment deletes a comment written by the authenticated user.
+//
+// @Summary Delete comment
+// @Description Deletes a comment. Only the author may delete a comment
+// @Tags comments
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param commentID path int true "Comment ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Comment belongs to another user"
+// @Failure 404 {object} apierrors.Response "Call or comment not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/comments/{commentID} [delete]
+func (h *CallsHandler) DeleteComment(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	commentID, err := strconv.ParseInt(c.Param("commentID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid comment ID"})
+		return
+	}
+
+	if err := h.u.DeleteComment(c.Request.Context(), callID, commentID, userID, orgID); err != nil {
+		h.commentError(c, err, "Failed to delete comment")
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Int64("commentID", commentID).Msg("Comment success deleted")
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *CallsHandler) commentError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrCallNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+	case errors.Is(err, usecase.ErrCommentNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Comment not found"})
+	case errors.Is(err, usecase.ErrNotCommentAuthor):
+		c.JSON(http.StatusForbidden, apierrors.Response{Error: "Comment belongs to another user"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
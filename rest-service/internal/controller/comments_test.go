@@ -0,0 +1,470 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddComment(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	saved := &entity.Comment{
+		ID:         7,
+		CallID:     1,
+		AuthorID:   123,
+		Body:       "Перезвонить после обеда",
+		IsInternal: true,
+		CreatedAt:  createdAt,
+	}
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		requestBody      string
+		mockComment      *entity.Comment
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful add",
+			callIDParam:      "1",
+			requestBody:      `{"body":"Перезвонить после обеда","is_internal":true}`,
+			mockComment:      saved,
+			expectedStatus:   http.StatusCreated,
+			expectedResponse: *saved,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			callIDParam:      "1",
+			requestBody:      `{"body":"text"}`,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Invalid call ID param",
+			callIDParam:      "abc",
+			requestBody:      `{"body":"text"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Empty body",
+			callIDParam:      "1",
+			requestBody:      `{"body":""}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			requestBody:      `{"body":"Перезвонить после обеда","is_internal":true}`,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			requestBody:      `{"body":"Перезвонить после обеда","is_internal":true}`,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to add comment"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("AddComment", mock.Anything, int64(123), int64(0), entity.Comment{
+					CallID:     1,
+					Body:       "Перезвонить после обеда",
+					IsInternal: true,
+				}).Return(tt.mockComment, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callIDParam+"/comments", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.AddComment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var response entity.Comment
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "AddComment")
+			}
+		})
+	}
+}
+
+func TestGetComments(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	internal := true
+	comments := []entity.Comment{
+		{ID: 7, CallID: 1, AuthorID: 123, Body: "Перезвонить после обеда", IsInternal: true, CreatedAt: createdAt},
+	}
+
+	tests := []struct {
+		name             string
+		callIDParam      string
+		query            string
+		expectedInternal *bool
+		mockComments     []entity.Comment
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful get",
+			callIDParam:      "1",
+			mockComments:     comments,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: comments,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Only internal notes",
+			callIDParam:      "1",
+			query:            "?internal=true",
+			expectedInternal: &internal,
+			mockComments:     comments,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: comments,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid internal flag",
+			callIDParam:      "1",
+			query:            "?internal=maybe",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			callIDParam:      "1",
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Call not found",
+			callIDParam:      "1",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			callIDParam:      "1",
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to get comments"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetComments", mock.Anything, int64(1), int64(123), int64(0), tt.expectedInternal).
+					Return(tt.mockComments, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: tt.callIDParam}}
+			c.Request = httptest.NewRequest("GET", "/calls/"+tt.callIDParam+"/comments"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetComments(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response []entity.Comment
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetComments")
+			}
+		})
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Hour)
+	body := "Перезвонить вечером"
+	updated := &entity.Comment{
+		ID:        7,
+		CallID:    1,
+		AuthorID:  123,
+		Body:      body,
+		CreatedAt: createdAt,
+		UpdatedAt: &updatedAt,
+		EditCount: 1,
+	}
+
+	tests := []struct {
+		name             string
+		commentIDParam   string
+		requestBody      string
+		mockComment      *entity.Comment
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful update",
+			commentIDParam:   "7",
+			requestBody:      `{"body":"Перезвонить вечером"}`,
+			mockComment:      updated,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: *updated,
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid comment ID param",
+			commentIDParam:   "abc",
+			requestBody:      `{"body":"Перезвонить вечером"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid comment ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "No fields to update",
+			commentIDParam:   "7",
+			requestBody:      `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "No fields to update"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Comment not found",
+			commentIDParam:   "7",
+			requestBody:      `{"body":"Перезвонить вечером"}`,
+			mockErr:          usecase.ErrCommentNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Comment not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Not the author",
+			commentIDParam:   "7",
+			requestBody:      `{"body":"Перезвонить вечером"}`,
+			mockErr:          usecase.ErrNotCommentAuthor,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Comment belongs to another user"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Internal server error",
+			commentIDParam:   "7",
+			requestBody:      `{"body":"Перезвонить вечером"}`,
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to update comment"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("UpdateComment", mock.Anything, entity.CommentUpdate{
+					ID:       7,
+					CallID:   1,
+					AuthorID: 123,
+					Body:     &body,
+				}).Return(tt.mockComment, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: "1"}, {Key: "commentID", Value: tt.commentIDParam}}
+			c.Request = httptest.NewRequest("PATCH", "/calls/1/comments/"+tt.commentIDParam, bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.UpdateComment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response entity.Comment
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "UpdateComment")
+			}
+		})
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	tests := []struct {
+		name             string
+		commentIDParam   string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		setupContext     func(c *gin.Context)
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful delete",
+			commentIDParam: "7",
+			expectedStatus: http.StatusNoContent,
+			setupContext:   func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock: true,
+		},
+		{
+			name:             "Unauthorized (missing user ID)",
+			commentIDParam:   "7",
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: apierrors.Response{Error: "Unauthorized"},
+			setupContext:     func(c *gin.Context) {},
+		},
+		{
+			name:             "Invalid comment ID param",
+			commentIDParam:   "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid comment ID"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+		},
+		{
+			name:             "Call not found",
+			commentIDParam:   "7",
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Not the author",
+			commentIDParam:   "7",
+			mockErr:          usecase.ErrNotCommentAuthor,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Comment belongs to another user"},
+			setupContext:     func(c *gin.Context) { c.Set("id", int64(123)) },
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("DeleteComment", mock.Anything, int64(1), int64(7), int64(123), int64(0)).
+					Return(tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			tt.setupContext(c)
+			c.Params = []gin.Param{{Key: "id", Value: "1"}, {Key: "commentID", Value: tt.commentIDParam}}
+			c.Request = httptest.NewRequest("DELETE", "/calls/1/comments/"+tt.commentIDParam, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DeleteComment(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus != http.StatusNoContent {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				assert.Empty(t, w.Body.Bytes())
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "DeleteComment")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+)
+
+func encodeCursor[T entity.CallsCursor | entity.ClientsCursor](cursor *T) string {
+	if cursor == nil {
+		return ""
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (*entity.CallsCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, usecase.ErrInvalidCursor
+	}
+	var cursor entity.CallsCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.SortBy == "" {
+		return nil, usecase.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func decodeClientsCursor(s string) (*entity.ClientsCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, usecase.ErrInvalidCursor
+	}
+	var cursor entity.ClientsCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID <= 0 {
+		return nil, usecase.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
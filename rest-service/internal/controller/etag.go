@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	errInvalidETag = errors.New("invalid entity tag")
+	errWeakETag    = errors.New("weak entity tag")
+)
+
+// formatETag renders a call version as a strong entity tag.
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatch extracts the call version from an If-Match value, or zero for
+// "*", which matches any version. If-Match uses the strong comparison of RFC
+// 9110, so a weak tag never matches and yields errWeakETag.
+func parseIfMatch(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "*" {
+		return 0, nil
+	}
+	if strings.HasPrefix(s, "W/") {
+		return 0, errWeakETag
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return 0, errInvalidETag
+	}
+	version, err := strconv.ParseInt(s[1:len(s)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errInvalidETag
+	}
+	return version, nil
+}
@@ -0,0 +1,288 @@
+package controller
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+)
+
+const exportTimeFormat = "2006-01-02 15:04:05"
+
+// exportColumns are the header of CSV and XLSX exports.
+var exportColumns = []string{
+	"id",
+	"client_name",
+	"phone_number",
+	"description",
+	"status",
+	"status_label",
+	"priority",
+	"sla_status",
+	"assignee_id",
+	"created_at",
+	"updated_at",
+	"due_at",
+	"tags",
+}
+
+var exportContentTypes = map[string]string{
+	"csv":   "text/csv; charset=utf-8",
+	"jsonl": "application/x-ndjson",
+	"xlsx":  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// ExportCalls streams the calls of the authenticated user as a file.
+//
+// @Summary Export user calls
+// @Description Streams all calls created by or assigned to the authenticated user as CSV, JSON Lines or XLSX. Accepts the filters and sort order of GET /calls; limit and cursor are ignored
+// @Tags calls
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string true "File format" Enums(csv, jsonl, xlsx)
+// @Param status query string false "Filter by status"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param phone_number query string false "Filter by part of phone number"
+// @Param client_name query string false "Filter by part of client name"
+// @Param tag query string false "Filter by tag name (case-insensitive)"
+// @Param assigned_to query string false "Only calls assigned to the authenticated user" Enums(me)
+// @Param priority query string false "Filter by priority" Enums(low, normal, high, critical)
+// @Param sla query string false "Filter by SLA state" Enums(ok, at_risk, breached)
+// @Param sort query string false "Sort field" Enums(created_at, client_name, status, id)
+// @Param order query string false "Sort order" Enums(asc, desc)
+// @Success 200 {file} file "Exported calls"
+// @Failure 400 {object} apierrors.Response "Invalid query parameters"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/export [get]
+func (h *CallsHandler) ExportCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.ExportCallsDTO
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	w := &exportResponse{
+		c:           c,
+		contentType: exportContentTypes[input.Format],
+		filename:    fmt.Sprintf("calls-%s.%s", time.Now().Format("20060102"), input.Format),
+	}
+	exporter := newCallsExporter(input.Format, w)
+
+	var exported int
+	err := h.u.ExportCalls(c.Request.Context(), callsQuery(userID, orgID, input.CallsFilterDTO), func(call entity.CallResponse) error {
+		exported++
+		return exporter.Write(call)
+	})
+	if err == nil {
+		err = exporter.Close()
+	}
+	if err == nil {
+		// An empty JSON Lines export has not written anything yet.
+		w.writeHeader()
+	}
+	if err != nil {
+		h.l.Error().Err(err).Int("exported", exported).Msg("Failed to export calls")
+		// Once the file has started there is no way to report the error but
+		// to cut it short.
+		if !c.Writer.Written() {
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to export calls"})
+		}
+		return
+	}
+
+	h.l.Info().Str("format", input.Format).Int("exported", exported).Msg("Calls exported")
+}
+
+// exportResponse writes the download headers right before the first byte of
+// an export, so that a failure before it can still get an error response.
+type exportResponse struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+}
+
+func (w *exportResponse) Write(p []byte) (int, error) {
+	w.writeHeader()
+	return w.c.Writer.Write(p)
+}
+
+func (w *exportResponse) writeHeader() {
+	if w.c.Writer.Written() {
+		return
+	}
+	w.c.Header("Content-Type", w.contentType)
+	w.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+	w.c.Writer.WriteHeaderNow()
+}
+
+// callsExporter encodes calls one by one. Close writes whatever is buffered.
+type callsExporter interface {
+	Write(entity.CallResponse) error
+	Close() error
+}
+
+func newCallsExporter(format string, w io.Writer) callsExporter {
+	switch format {
+	case "jsonl":
+		bw := bufio.NewWriter(w)
+		return &jsonlExporter{w: bw, enc: json.NewEncoder(bw)}
+	case "xlsx":
+		return &xlsxExporter{w: w}
+	default:
+		return &csvExporter{w: csv.NewWriter(w)}
+	}
+}
+
+type csvExporter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvExporter) Write(call entity.CallResponse) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(exportColumns); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	values := exportValues(call)
+	record := make([]string, len(values))
+	for i, v := range values {
+		if v != nil {
+			record[i] = fmt.Sprint(v)
+		}
+	}
+	return e.w.Write(record)
+}
+
+func (e *csvExporter) Close() error {
+	if !e.wroteHeader {
+		if err := e.w.Write(exportColumns); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonlExporter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (e *jsonlExporter) Write(call entity.CallResponse) error {
+	return e.enc.Encode(call)
+}
+
+func (e *jsonlExporter) Close() error {
+	return e.w.Flush()
+}
+
+// xlsxExporter builds the workbook with the excelize stream writer, which
+// keeps only a small window of rows in memory and spills the rest to a
+// temporary file. The workbook is written out on Close.
+type xlsxExporter struct {
+	w   io.Writer
+	f   *excelize.File
+	sw  *excelize.StreamWriter
+	row int
+}
+
+func (e *xlsxExporter) init() error {
+	e.f = excelize.NewFile()
+	sw, err := e.f.NewStreamWriter(e.f.GetSheetName(0))
+	if err != nil {
+		return err
+	}
+	e.sw = sw
+
+	header := make([]any, len(exportColumns))
+	for i, col := range exportColumns {
+		header[i] = col
+	}
+	e.row = 1
+	return e.sw.SetRow("A1", header)
+}
+
+func (e *xlsxExporter) Write(call entity.CallResponse) error {
+	if e.f == nil {
+		if err := e.init(); err != nil {
+			return err
+		}
+	}
+
+	e.row++
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+	return e.sw.SetRow(cell, exportValues(call))
+}
+
+func (e *xlsxExporter) Close() error {
+	if e.f == nil {
+		if err := e.init(); err != nil {
+			return err
+		}
+	}
+	defer e.f.Close()
+
+	if err := e.sw.Flush(); err != nil {
+		return err
+	}
+	return e.f.Write(e.w)
+}
+
+// exportValues returns the values of call in the order of exportColumns.
+// Missing optional values are nil.
+func exportValues(call entity.CallResponse) []any {
+	var assigneeID, dueAt any
+	if call.AssigneeID != nil {
+		assigneeID = *call.AssigneeID
+	}
+	if call.DueAt != nil {
+		dueAt = call.DueAt.Format(exportTimeFormat)
+	}
+
+	return []any{
+		call.ID,
+		call.ClientName,
+		call.PhoneNumber,
+		call.Description,
+		call.Status,
+		call.StatusLabel,
+		call.Priority,
+		call.SLAStatus,
+		assigneeID,
+		call.CreatedAt.Format(exportTimeFormat),
+		call.UpdatedAt.Format(exportTimeFormat),
+		dueAt,
+		strings.Join(call.Tags, ", "),
+	}
+}
@@ -0,0 +1,170 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestExportCalls(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	assigneeID := int64(7)
+	calls := []entity.CallResponse{
+		{ID: 1, ClientName: "John Doe", PhoneNumber: "+79876543211", Description: "Test call", Status: "new", StatusLabel: "Новая", Priority: "normal", SLAStatus: "ok", CreatedAt: createdAt, UpdatedAt: createdAt, Version: 1, Tags: []string{"billing"}},
+		{ID: 2, ClientName: "Doe, Jane", PhoneNumber: "+79876543212", Description: "Test call", Status: "closed", StatusLabel: "Закрыта", Priority: "high", SLAStatus: "ok", CreatedAt: createdAt, UpdatedAt: createdAt, Version: 3, AssigneeID: &assigneeID},
+	}
+
+	csvHeader := "id,client_name,phone_number,description,status,status_label,priority,sla_status,assignee_id,created_at,updated_at,due_at,tags\n"
+
+	tests := []struct {
+		name                string
+		query               string
+		expectedQuery       entity.CallsQuery
+		failAfter           int
+		mockErr             error
+		expectedStatus      int
+		expectedContentType string
+		checkBody           func(t *testing.T, body []byte)
+		shouldCallMock      bool
+	}{
+		{
+			name:                "CSV export",
+			query:               "?format=csv&status=new&assigned_to=me&tag=Billing&order=asc",
+			expectedQuery:       entity.CallsQuery{UserID: 123, AssigneeID: 123, Status: "new", Tag: "Billing"},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			checkBody: func(t *testing.T, body []byte) {
+				assert.Equal(t, csvHeader+
+					"1,John Doe,+79876543211,Test call,new,Новая,normal,ok,,2024-03-01 10:00:00,2024-03-01 10:00:00,,billing\n"+
+					"2,\"Doe, Jane\",+79876543212,Test call,closed,Закрыта,high,ok,7,2024-03-01 10:00:00,2024-03-01 10:00:00,,\n", string(body))
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:                "JSON Lines export",
+			query:               "?format=jsonl&sort=id",
+			expectedQuery:       entity.CallsQuery{UserID: 123, SortBy: "id", SortDesc: true},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			checkBody: func(t *testing.T, body []byte) {
+				lines := bytes.Split(bytes.TrimSpace(body), []byte("\n"))
+				assert.Len(t, lines, 2)
+				var call entity.CallResponse
+				assert.NoError(t, json.Unmarshal(lines[1], &call))
+				assert.Equal(t, calls[1], call)
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:                "XLSX export",
+			query:               "?format=xlsx",
+			expectedQuery:       entity.CallsQuery{UserID: 123, SortDesc: true},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			checkBody: func(t *testing.T, body []byte) {
+				f, err := excelize.OpenReader(bytes.NewReader(body))
+				assert.NoError(t, err)
+				rows, err := f.GetRows(f.GetSheetName(0))
+				assert.NoError(t, err)
+				assert.Len(t, rows, 3)
+				assert.Equal(t, []string{"2", "Doe, Jane", "+79876543212", "Test call", "closed", "Закрыта", "high", "ok", "7", "2024-03-01 10:00:00", "2024-03-01 10:00:00"}, rows[2])
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:           "Failure before the first row",
+			query:          "?format=csv",
+			expectedQuery:  entity.CallsQuery{UserID: 123, SortDesc: true},
+			failAfter:      0,
+			mockErr:        errors.New("db error"),
+			expectedStatus: http.StatusInternalServerError,
+			checkBody: func(t *testing.T, body []byte) {
+				var response apierrors.Response
+				assert.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, apierrors.Response{Error: "Failed to export calls"}, response)
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:          "Failure after the file has started",
+			query:         "?format=jsonl",
+			expectedQuery: entity.CallsQuery{UserID: 123, SortDesc: true},
+			// The JSON Lines buffer is flushed only on success, so a failure
+			// after a row is still reported.
+			failAfter:      1,
+			mockErr:        errors.New("db error"),
+			expectedStatus: http.StatusInternalServerError,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Missing format",
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+			shouldCallMock: false,
+		},
+		{
+			name:           "Unknown format",
+			query:          "?format=pdf",
+			expectedStatus: http.StatusBadRequest,
+			shouldCallMock: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("ExportCalls", mock.Anything, tt.expectedQuery, mock.Anything).
+					Return(func(_ context.Context, _ entity.CallsQuery, fn func(entity.CallResponse) error) error {
+						for i, call := range calls {
+							if tt.mockErr != nil && i == tt.failAfter {
+								return tt.mockErr
+							}
+							if err := fn(call); err != nil {
+								return err
+							}
+						}
+						return nil
+					})
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("GET", "/calls/export"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.ExportCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+				assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment; filename=\"calls-")
+			}
+			if tt.checkBody != nil {
+				tt.checkBody(t, w.Body.Bytes())
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "ExportCalls")
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package controller
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/dataloader"
+	"github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	// maxGQLPage is the largest page of calls, as with GET /calls. Fields of
+	// a list are resolved in parallel up to it, so that the dataloaders get
+	// the whole page in one batch.
+	maxGQLPage = 100
+
+	gqlSubprotocol = "graphql-transport-ws"
+	// gqlInitTimeout is how long a socket may stay open before it sends
+	// connection_init.
+	gqlInitTimeout = 10 * time.Second
+)
+
+// Close codes of the graphql-transport-ws protocol.
+const (
+	gqlCloseInvalidMessage     = 4400
+	gqlCloseUnauthorized       = 4401
+	gqlCloseSubprotocol        = 4406
+	gqlCloseInitTimeout        = 4408
+	gqlCloseSubscriberExists   = 4409
+	gqlCloseTooManyInitRequest = 4429
+)
+
+func newGQLSchema(h *CallsHandler) *graphql.Schema {
+	return graphql.MustParseSchema(schemaSDL, &gqlResolver{h: h},
+		graphql.UseStringDescriptions(),
+		graphql.MaxParallelism(maxGQLPage),
+	)
+}
+
+// GraphQL executes a GraphQL query or mutation.
+//
+// @Summary GraphQL queries and mutations
+// @Description Executes an operation of the GraphQL schema of the calls, served at rest-service/internal/controller/schema.graphql: the current user, calls with their comments and client, creating calls, changing their status and deleting them. Errors of the operation are returned in the errors field with status 200, with their code in extensions.code: UNAUTHENTICATED, BAD_USER_INPUT, NOT_FOUND, CONFLICT or INTERNAL. Subscriptions are served over the WebSocket of GET /graphql
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param input body entity.GraphQLRequest true "GraphQL operation"
+// @Success 200 {object} map[string]any "Data and errors of the operation"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /graphql [post]
+func (h *CallsHandler) GraphQL(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	var input entity.GraphQLRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	orgID := c.GetInt64("org_id")
+
+	ctx := withGQLViewer(c.Request.Context(), &gqlViewer{
+		userID:  userID,
+		orgID:   orgID,
+		role:    c.GetString("role"),
+		loaders: newGQLLoaders(h.u, userID, orgID),
+	})
+
+	c.JSON(http.StatusOK, h.graphql.Exec(ctx, input.Query, input.OperationName, input.Variables))
+}
+
+// GraphQLWebSocket serves GraphQL operations, subscriptions among them, over
+// a WebSocket.
+//
+// @Summary GraphQL over WebSocket
+// @Description Upgrades the connection to a WebSocket speaking the graphql-transport-ws subprotocol, which the client must request. The client sends connection_init within 10 seconds, then subscribe messages with the same payload as POST /graphql; each result is sent in a next message, and complete ends the operation. The callChanged subscription ends when the client falls behind or the service stops, after which the client should reload the calls. The server pings the client every 15 seconds and closes the socket if it does not answer. Browsers may pass the token in the access_token query parameter
+// @Tags graphql
+// @Param access_token query string false "JWT, instead of the Authorization header"
+// @Success 101 {object} map[string]any "graphql-transport-ws messages"
+// @Failure 400 {object} apierrors.Response "Not a WebSocket handshake"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /graphql [get]
+func (h *CallsHandler) GraphQLWebSocket(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	// Upgrade replies with an error itself.
+	conn, err := h.gqlUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	s := &gqlSession{
+		h:      h,
+		conn:   conn,
+		userID: userID,
+		orgID:  c.GetInt64("org_id"),
+		role:   c.GetString("role"),
+		ops:    make(map[string]*gqlOperation),
+	}
+
+	if conn.Subprotocol() != gqlSubprotocol {
+		s.close(gqlCloseSubprotocol, "Subprotocol not acceptable")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer s.wg.Wait()
+	defer cancel()
+
+	s.serve(ctx)
+}
+
+// gqlMessage is a message of the graphql-transport-ws protocol.
+type gqlMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// gqlOperation is an operation started by a subscribe message.
+type gqlOperation struct {
+	cancel context.CancelFunc
+}
+
+// gqlSession is a graphql-transport-ws connection. Operations run in
+// goroutines of their own and share the connection for writing.
+type gqlSession struct {
+	h      *CallsHandler
+	conn   *websocket.Conn
+	userID int64
+	orgID  int64
+	role   string
+
+	writeMu sync.Mutex
+
+	opsMu sync.Mutex
+	ops   map[string]*gqlOperation
+	wg    sync.WaitGroup
+}
+
+// serve reads the messages of the client until the socket is closed.
+func (s *gqlSession) serve(ctx context.Context) {
+	s.conn.SetReadLimit(64 << 10)
+	_ = s.conn.SetReadDeadline(time.Now().Add(gqlInitTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	})
+
+	var acked bool
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			var netErr net.Error
+			if !acked && errors.As(err, &netErr) && netErr.Timeout() {
+				s.close(gqlCloseInitTimeout, "Connection initialisation timeout")
+			}
+			return
+		}
+
+		var msg gqlMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.close(gqlCloseInvalidMessage, "Invalid message")
+			return
+		}
+
+		switch msg.Type {
+		case "connection_init":
+			if acked {
+				s.close(gqlCloseTooManyInitRequest, "Too many initialisation requests")
+				return
+			}
+			acked = true
+			_ = s.conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+			if err := s.write(gqlMessage{Type: "connection_ack"}); err != nil {
+				return
+			}
+			s.wg.Add(1)
+			go s.heartbeat(ctx)
+		case "ping":
+			if err := s.write(gqlMessage{Type: "pong"}); err != nil {
+				return
+			}
+		case "pong":
+		case "subscribe":
+			if !acked {
+				s.close(gqlCloseUnauthorized, "Unauthorized")
+				return
+			}
+			var req entity.GraphQLRequest
+			if msg.ID == "" || json.Unmarshal(msg.Payload, &req) != nil || req.Query == "" {
+				s.close(gqlCloseInvalidMessage, "Invalid message")
+				return
+			}
+			if !s.start(ctx, msg.ID, req) {
+				s.close(gqlCloseSubscriberExists, "Subscriber for "+msg.ID+" already exists")
+				return
+			}
+		case "complete":
+			s.stop(msg.ID)
+		default:
+			s.close(gqlCloseInvalidMessage, "Invalid message")
+			return
+		}
+	}
+}
+
+// start runs an operation unless one with the same ID is running.
+func (s *gqlSession) start(ctx context.Context, id string, req entity.GraphQLRequest) bool {
+	s.opsMu.Lock()
+	defer s.opsMu.Unlock()
+
+	if _, ok := s.ops[id]; ok {
+		return false
+	}
+
+	// An operation may live as long as the socket, too long to cache what it
+	// loads.
+	ctx, cancel := context.WithCancel(withGQLViewer(ctx, &gqlViewer{
+		userID:  s.userID,
+		orgID:   s.orgID,
+		role:    s.role,
+		loaders: newGQLLoaders(s.h.u, s.userID, s.orgID, dataloader.WithCache(&dataloader.NoCache{})),
+	}))
+	op := &gqlOperation{cancel: cancel}
+	s.ops[id] = op
+
+	s.wg.Add(1)
+	go s.run(ctx, id, op, req)
+	return true
+}
+
+// stop cancels an operation the client completed.
+func (s *gqlSession) stop(id string) {
+	s.opsMu.Lock()
+	defer s.opsMu.Unlock()
+
+	if op, ok := s.ops[id]; ok {
+		op.cancel()
+		delete(s.ops, id)
+	}
+}
+
+func (s *gqlSession) run(ctx context.Context, id string, op *gqlOperation, req entity.GraphQLRequest) {
+	defer s.wg.Done()
+
+	responses, err := s.h.graphql.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		s.h.l.Error().Err(err).Msg("Failed to subscribe to GraphQL operation")
+		s.finish(id, op, gqlMessage{ID: id, Type: "error", Payload: gqlPayload([]map[string]string{{"message": "Failed to execute operation"}})})
+		return
+	}
+
+	first := true
+	for r := range responses {
+		resp := r.(*graphql.Response)
+		// An operation that failed before producing any data is refused
+		// with an error message, which also ends it.
+		if first && len(resp.Errors) > 0 && (len(resp.Data) == 0 || string(resp.Data) == "null") {
+			s.finish(id, op, gqlMessage{ID: id, Type: "error", Payload: gqlPayload(resp.Errors)})
+			return
+		}
+		first = false
+
+		if err := s.write(gqlMessage{ID: id, Type: "next", Payload: gqlPayload(resp)}); err != nil {
+			op.cancel()
+			return
+		}
+	}
+
+	s.finish(id, op, gqlMessage{ID: id, Type: "complete"})
+}
+
+// finish removes an operation and sends its last message, unless the client
+// completed it first.
+func (s *gqlSession) finish(id string, op *gqlOperation, last gqlMessage) {
+	s.opsMu.Lock()
+	current, ok := s.ops[id]
+	if ok && current == op {
+		delete(s.ops, id)
+	}
+	s.opsMu.Unlock()
+
+	op.cancel()
+	if ok && current == op {
+		_ = s.write(last)
+	}
+}
+
+// heartbeat pings the client until the session ends, so that a client which
+// went away stops the read loop.
+func (s *gqlSession) heartbeat(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(streamHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *gqlSession) write(msg gqlMessage) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return s.conn.WriteJSON(msg)
+}
+
+func (s *gqlSession) close(code int, reason string) {
+	_ = s.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason), time.Now().Add(wsWriteWait))
+}
+
+func gqlPayload(v any) json.RawMessage {
+	data, _ := json.Marshal(v)
+	return data
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// gqlKey is an ID loaded by a dataloader.
+type gqlKey int64
+
+func (k gqlKey) String() string {
+	return strconv.FormatInt(int64(k), 10)
+}
+
+func (k gqlKey) Raw() any {
+	return int64(k)
+}
+
+// gqlLoaders batch the lookups made for each call of a list into one request
+// to the use case per field.
+type gqlLoaders struct {
+	comments *dataloader.Loader
+	clients  *dataloader.Loader
+}
+
+// newGQLLoaders returns the loaders of the user in the organization. A query gets loaders of its
+// own, which cache what they load; a subscription lives too long for that and
+// passes dataloader.WithCache(&dataloader.NoCache{}).
+func newGQLLoaders(u usecase.UseCase, userID, orgID int64, opts ...dataloader.Option) *gqlLoaders {
+	return &gqlLoaders{
+		comments: dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+			ids := gqlKeyIDs(keys)
+			byCall, err := u.GetCallsComments(ctx, userID, orgID, ids)
+			return gqlResults(ids, err, func(id int64) any { return byCall[id] })
+		}, opts...),
+		clients: dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+			ids := gqlKeyIDs(keys)
+			byID, err := u.GetClientsByIDs(ctx, userID, orgID, ids)
+			return gqlResults(ids, err, func(id int64) any {
+				if client, ok := byID[id]; ok {
+					return &client
+				}
+				return nil
+			})
+		}, opts...),
+	}
+}
+
+func gqlKeyIDs(keys dataloader.Keys) []int64 {
+	ids := make([]int64, len(keys))
+	for i, key := range keys {
+		ids[i] = key.Raw().(int64)
+	}
+	return ids
+}
+
+// gqlResults answers every key of a batch with its value or with the error
+// of the whole batch.
+func gqlResults(ids []int64, err error, value func(int64) any) []*dataloader.Result {
+	results := make([]*dataloader.Result, len(ids))
+	for i, id := range ids {
+		if err != nil {
+			results[i] = &dataloader.Result{Error: err}
+			continue
+		}
+		results[i] = &dataloader.Result{Data: value(id)}
+	}
+	return results
+}
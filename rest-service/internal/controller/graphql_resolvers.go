@@ -0,0 +1,632 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Codes of GraphQL errors, passed in their extensions.
+const (
+	gqlUnauthenticated = "UNAUTHENTICATED"
+	gqlBadInput        = "BAD_USER_INPUT"
+	gqlNotFound        = "NOT_FOUND"
+	gqlConflict        = "CONFLICT"
+	gqlInternal        = "INTERNAL"
+)
+
+// gqlError is an error shown to GraphQL clients, with its code and details
+// in the extensions.
+type gqlError struct {
+	message    string
+	extensions map[string]any
+}
+
+func newGQLError(code, message string) *gqlError {
+	return &gqlError{message: message, extensions: map[string]any{"code": code}}
+}
+
+func (e *gqlError) Error() string {
+	return e.message
+}
+
+func (e *gqlError) Extensions() map[string]any {
+	return e.extensions
+}
+
+// gqlViewer is the authenticated user of a GraphQL operation.
+type gqlViewer struct {
+	userID  int64
+	orgID   int64
+	role    string
+	loaders *gqlLoaders
+}
+
+type gqlViewerKey struct{}
+
+func withGQLViewer(ctx context.Context, v *gqlViewer) context.Context {
+	return context.WithValue(ctx, gqlViewerKey{}, v)
+}
+
+func gqlViewerFrom(ctx context.Context) (*gqlViewer, error) {
+	v, ok := ctx.Value(gqlViewerKey{}).(*gqlViewer)
+	if !ok {
+		return nil, newGQLError(gqlUnauthenticated, "Unauthorized")
+	}
+	return v, nil
+}
+
+func parseGQLID(id graphql.ID, message string) (int64, error) {
+	n, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return 0, newGQLError(gqlBadInput, message)
+	}
+	return n, nil
+}
+
+// gqlResolver resolves the root fields of the schema through the use case of
+// the handler, with the authorization of the REST API.
+type gqlResolver struct {
+	h *CallsHandler
+}
+
+func (r *gqlResolver) Me(ctx context.Context) (*gqlUser, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlUser{h: r.h, id: v.userID, role: v.role}, nil
+}
+
+func (r *gqlResolver) Call(ctx context.Context, args struct{ ID graphql.ID }) (*gqlCall, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	callID, err := parseGQLID(args.ID, "Invalid call ID")
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := r.h.u.GetUserCallByID(ctx, callID, v.userID, v.orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return nil, nil
+		}
+		r.h.l.Error().Err(err).Msg("Failed to get user call by ID")
+		return nil, newGQLError(gqlInternal, "Failed to get user call")
+	}
+
+	return &gqlCall{h: r.h, call: *call}, nil
+}
+
+type gqlCallsFilter struct {
+	Status       *string
+	CreatedFrom  *graphql.Time
+	CreatedTo    *graphql.Time
+	PhoneNumber  *string
+	ClientName   *string
+	AssignedToMe *bool
+	Priority     *string
+	SLA          *string
+	Tag          *string
+	Sort         *string
+	Order        *string
+}
+
+func (f *gqlCallsFilter) dto() entity.CallsFilterDTO {
+	var dto entity.CallsFilterDTO
+	if f == nil {
+		return dto
+	}
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	dto.Status = deref(f.Status)
+	dto.PhoneNumber = deref(f.PhoneNumber)
+	dto.ClientName = deref(f.ClientName)
+	dto.Priority = deref(f.Priority)
+	dto.SLA = deref(f.SLA)
+	dto.Tag = deref(f.Tag)
+	dto.Sort = deref(f.Sort)
+	dto.Order = deref(f.Order)
+	if f.CreatedFrom != nil {
+		dto.CreatedFrom = f.CreatedFrom.Time
+	}
+	if f.CreatedTo != nil {
+		dto.CreatedTo = f.CreatedTo.Time
+	}
+	if f.AssignedToMe != nil && *f.AssignedToMe {
+		dto.AssignedTo = "me"
+	}
+	return dto
+}
+
+func (r *gqlResolver) Calls(ctx context.Context, args struct {
+	First  int32
+	After  *string
+	Filter *gqlCallsFilter
+}) (*gqlCallsPage, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := callsQuery(v.userID, v.orgID, args.Filter.dto())
+	if args.First < 1 || args.First > maxGQLPage {
+		return nil, newGQLError(gqlBadInput, "first must be between 1 and 100")
+	}
+	query.Limit = int(args.First)
+	if args.After != nil {
+		if query.After, err = decodeCursor(*args.After); err != nil {
+			return nil, newGQLError(gqlBadInput, "Invalid cursor")
+		}
+	}
+
+	page, err := r.h.u.GetUserCalls(ctx, query)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCursor) {
+			return nil, newGQLError(gqlBadInput, "Invalid cursor")
+		}
+		r.h.l.Error().Err(err).Msg("Failed to get user calls")
+		return nil, newGQLError(gqlInternal, "Failed to get user calls")
+	}
+
+	return &gqlCallsPage{h: r.h, page: page}, nil
+}
+
+type gqlCreateCallInput struct {
+	ClientName  string
+	PhoneNumber string
+	Description string
+	Priority    *string
+	Force       *bool
+}
+
+func (r *gqlResolver) CreateCall(ctx context.Context, args struct{ Input gqlCreateCallInput }) (*gqlCall, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := entity.CallDTO{
+		ClientName:  args.Input.ClientName,
+		PhoneNumber: args.Input.PhoneNumber,
+		Description: args.Input.Description,
+	}
+	if args.Input.Priority != nil {
+		input.Priority = *args.Input.Priority
+	}
+	if err := binding.Validator.ValidateStruct(input); err != nil {
+		return nil, newGQLError(gqlBadInput, "Invalid request format")
+	}
+
+	number, err := r.h.phones.Parse(input.PhoneNumber)
+	if err != nil {
+		return nil, newGQLError(gqlBadInput, "Invalid phone number format")
+	}
+
+	newCall := entity.Call{
+		ClientName:  input.ClientName,
+		PhoneNumber: input.PhoneNumber,
+		PhoneE164:   number.E164(),
+		Description: input.Description,
+		Status:      entity.StatusNew,
+		UserID:      v.userID,
+		OrgID:       v.orgID,
+		Priority:    input.Priority,
+	}
+
+	force := args.Input.Force != nil && *args.Input.Force
+	callID, err := r.h.u.SaveCall(ctx, newCall, force)
+	if err != nil {
+		var dupErr *usecase.DuplicateCallError
+		if errors.As(err, &dupErr) {
+			gqlErr := newGQLError(gqlConflict, "Call duplicates an open call")
+			gqlErr.extensions["callId"] = strconv.FormatInt(dupErr.CallID, 10)
+			return nil, gqlErr
+		}
+		r.h.l.Error().Err(err).Msg("Failed to save call")
+		return nil, newGQLError(gqlInternal, "Failed to save call")
+	}
+
+	r.h.l.Info().Int64("callID", callID).Msg("Call success save")
+
+	return r.reload(ctx, callID, v)
+}
+
+func (r *gqlResolver) UpdateCallStatus(ctx context.Context, args struct {
+	ID     graphql.ID
+	Status string
+}) (*gqlCall, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	callID, err := parseGQLID(args.ID, "Invalid call ID")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.h.u.UpdateCallStatus(ctx, callID, v.userID, v.orgID, args.Status); err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return nil, newGQLError(gqlNotFound, "Call not found or does not belong to user")
+		}
+		var transitionErr *usecase.TransitionError
+		if errors.As(err, &transitionErr) {
+			gqlErr := newGQLError(gqlConflict, "Status transition is not allowed")
+			gqlErr.extensions["currentStatus"] = transitionErr.From
+			gqlErr.extensions["allowedStatuses"] = transitionErr.Allowed
+			return nil, gqlErr
+		}
+		if errors.Is(err, usecase.ErrStatusChanged) {
+			return nil, newGQLError(gqlConflict, "Call status was changed by another request")
+		}
+		r.h.l.Error().Err(err).Msg("Failed to update call status")
+		return nil, newGQLError(gqlInternal, "Failed to update call status")
+	}
+
+	r.h.l.Info().Int64("callID", callID).Msg("Call success update")
+
+	return r.reload(ctx, callID, v)
+}
+
+func (r *gqlResolver) DeleteCall(ctx context.Context, args struct{ ID graphql.ID }) (graphql.ID, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	callID, err := parseGQLID(args.ID, "Invalid call ID")
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.h.u.DeleteCall(ctx, callID, v.userID, v.orgID); err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return "", newGQLError(gqlNotFound, "Call not found or does not belong to user")
+		}
+		r.h.l.Error().Err(err).Msg("Failed to delete call")
+		return "", newGQLError(gqlInternal, "Failed to delete call")
+	}
+
+	r.h.l.Info().Int64("callID", callID).Msg("Call success deleted")
+
+	return args.ID, nil
+}
+
+// reload returns a call after a mutation.
+func (r *gqlResolver) reload(ctx context.Context, callID int64, v *gqlViewer) (*gqlCall, error) {
+	call, err := r.h.u.GetUserCallByID(ctx, callID, v.userID, v.orgID)
+	if err != nil {
+		r.h.l.Error().Err(err).Msg("Failed to get user call by ID")
+		return nil, newGQLError(gqlInternal, "Failed to get user call")
+	}
+	return &gqlCall{h: r.h, call: *call}, nil
+}
+
+// CallChanged streams the changes until the operation is completed. The
+// stream ends when the client falls too far behind or the service stops,
+// after which the client should reload the calls.
+func (r *gqlResolver) CallChanged(ctx context.Context) (<-chan *gqlCallChange, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := r.h.u.SubscribeCalls(ctx, v.userID, v.orgID)
+
+	changes := make(chan *gqlCallChange)
+	go func() {
+		defer close(changes)
+		for e := range events {
+			select {
+			case changes <- &gqlCallChange{h: r.h, e: e}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+type gqlUser struct {
+	h    *CallsHandler
+	id   int64
+	role string
+}
+
+func (u *gqlUser) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(u.id, 10))
+}
+
+func (u *gqlUser) Username(ctx context.Context) (string, error) {
+	user, err := u.h.u.GetUser(ctx, u.id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return "", newGQLError(gqlNotFound, "User not found")
+		}
+		u.h.l.Error().Err(err).Msg("Failed to get user")
+		return "", newGQLError(gqlInternal, "Failed to get user")
+	}
+	return user.Username, nil
+}
+
+func (u *gqlUser) Role() string {
+	return u.role
+}
+
+type gqlCallsPage struct {
+	h    *CallsHandler
+	page *entity.CallsPage
+}
+
+func (p *gqlCallsPage) Items() []*gqlCall {
+	items := make([]*gqlCall, len(p.page.Items))
+	for i, call := range p.page.Items {
+		items[i] = &gqlCall{h: p.h, call: call}
+	}
+	return items
+}
+
+func (p *gqlCallsPage) NextCursor() *string {
+	if p.page.Next == nil {
+		return nil
+	}
+	cursor := encodeCursor(p.page.Next)
+	return &cursor
+}
+
+type gqlCall struct {
+	h    *CallsHandler
+	call entity.CallResponse
+}
+
+func (c *gqlCall) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.call.ID, 10))
+}
+
+func (c *gqlCall) ClientName() string {
+	return c.call.ClientName
+}
+
+func (c *gqlCall) PhoneNumber() string {
+	return c.call.PhoneNumber
+}
+
+func (c *gqlCall) PhoneE164() *string {
+	return gqlOptional(c.call.PhoneE164)
+}
+
+func (c *gqlCall) PhoneNational() *string {
+	return gqlOptional(c.call.PhoneNational)
+}
+
+func (c *gqlCall) Description() string {
+	return c.call.Description
+}
+
+func (c *gqlCall) Status() string {
+	return c.call.Status
+}
+
+func (c *gqlCall) StatusLabel() string {
+	return c.call.StatusLabel
+}
+
+func (c *gqlCall) AllowedStatuses() []string {
+	return usecase.AllowedTransitions(c.call.Status)
+}
+
+func (c *gqlCall) CreatedAt() graphql.Time {
+	return graphql.Time{Time: c.call.CreatedAt}
+}
+
+func (c *gqlCall) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: c.call.UpdatedAt}
+}
+
+func (c *gqlCall) Version() int32 {
+	return int32(c.call.Version)
+}
+
+func (c *gqlCall) AssigneeID() *graphql.ID {
+	if c.call.AssigneeID == nil {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(*c.call.AssigneeID, 10))
+	return &id
+}
+
+func (c *gqlCall) Priority() string {
+	return c.call.Priority
+}
+
+func (c *gqlCall) DueAt() *graphql.Time {
+	return gqlTime(c.call.DueAt)
+}
+
+func (c *gqlCall) SLAStatus() string {
+	return c.call.SLAStatus
+}
+
+func (c *gqlCall) ClosedAt() *graphql.Time {
+	return gqlTime(c.call.ClosedAt)
+}
+
+func (c *gqlCall) Tags() []string {
+	return c.call.Tags
+}
+
+func (c *gqlCall) Client(ctx context.Context) (*gqlClient, error) {
+	if c.call.ClientID == nil {
+		return nil, nil
+	}
+
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := v.loaders.clients.Load(ctx, gqlKey(*c.call.ClientID))()
+	if err != nil {
+		c.h.l.Error().Err(err).Msg("Failed to get clients")
+		return nil, newGQLError(gqlInternal, "Failed to get client")
+	}
+
+	client, _ := data.(*entity.Client)
+	if client == nil {
+		return nil, nil
+	}
+	return &gqlClient{client: *client}, nil
+}
+
+func (c *gqlCall) Comments(ctx context.Context, args struct{ Internal *bool }) ([]*gqlComment, error) {
+	v, err := gqlViewerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := v.loaders.comments.Load(ctx, gqlKey(c.call.ID))()
+	if err != nil {
+		c.h.l.Error().Err(err).Msg("Failed to get comments")
+		return nil, newGQLError(gqlInternal, "Failed to get comments")
+	}
+
+	comments, _ := data.([]entity.Comment)
+	resolvers := make([]*gqlComment, 0, len(comments))
+	for _, comment := range comments {
+		if args.Internal != nil && comment.IsInternal != *args.Internal {
+			continue
+		}
+		resolvers = append(resolvers, &gqlComment{comment: comment})
+	}
+	return resolvers, nil
+}
+
+func (c *gqlCall) CallbackAt() *graphql.Time {
+	return gqlTime(c.call.CallbackAt)
+}
+
+func (c *gqlCall) CallbackTimezone() *string {
+	return gqlOptional(c.call.CallbackTimezone)
+}
+
+type gqlClient struct {
+	client entity.Client
+}
+
+func (c *gqlClient) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.client.ID, 10))
+}
+
+func (c *gqlClient) Name() string {
+	return c.client.Name
+}
+
+func (c *gqlClient) PhoneNumber() string {
+	return c.client.PhoneNumber
+}
+
+func (c *gqlClient) PhoneNational() string {
+	return c.client.PhoneNational
+}
+
+func (c *gqlClient) CreatedAt() graphql.Time {
+	return graphql.Time{Time: c.client.CreatedAt}
+}
+
+func (c *gqlClient) CallsCount() int32 {
+	return int32(c.client.CallsCount)
+}
+
+func (c *gqlClient) LastCallAt() graphql.Time {
+	return graphql.Time{Time: c.client.LastCallAt}
+}
+
+type gqlComment struct {
+	comment entity.Comment
+}
+
+func (c *gqlComment) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.comment.ID, 10))
+}
+
+func (c *gqlComment) AuthorID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.comment.AuthorID, 10))
+}
+
+func (c *gqlComment) Body() string {
+	return c.comment.Body
+}
+
+func (c *gqlComment) IsInternal() bool {
+	return c.comment.IsInternal
+}
+
+func (c *gqlComment) CreatedAt() graphql.Time {
+	return graphql.Time{Time: c.comment.CreatedAt}
+}
+
+func (c *gqlComment) UpdatedAt() *graphql.Time {
+	return gqlTime(c.comment.UpdatedAt)
+}
+
+func (c *gqlComment) EditCount() int32 {
+	return int32(c.comment.EditCount)
+}
+
+type gqlCallChange struct {
+	h *CallsHandler
+	e entity.CallStreamEvent
+}
+
+func (c *gqlCallChange) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.e.ID, 10))
+}
+
+func (c *gqlCallChange) Type() string {
+	return c.e.Type
+}
+
+func (c *gqlCallChange) Event() string {
+	return c.e.Event
+}
+
+func (c *gqlCallChange) CallID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(c.e.CallID, 10))
+}
+
+func (c *gqlCallChange) Call() *gqlCall {
+	if c.e.Call == nil {
+		return nil
+	}
+	return &gqlCall{h: c.h, call: *c.e.Call}
+}
+
+func gqlOptional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func gqlTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
@@ -0,0 +1,366 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/middleware"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func postGraphQL(t *testing.T, h *controller.CallsHandler, body string) (*httptest.ResponseRecorder, graphQLResponse) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("id", int64(123))
+	c.Set("role", entity.RoleOperator)
+	c.Request = httptest.NewRequest("POST", "/graphql", bytes.NewBufferString(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	h.GraphQL(c)
+
+	var resp graphQLResponse
+	if w.Code == http.StatusOK {
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	}
+	return w, resp
+}
+
+func graphQLBody(t *testing.T, query string, variables map[string]any) string {
+	t.Helper()
+
+	body, err := json.Marshal(entity.GraphQLRequest{Query: query, Variables: variables})
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func sameIDs(want ...int64) any {
+	return mock.MatchedBy(func(ids []int64) bool {
+		got := slices.Clone(ids)
+		slices.Sort(got)
+		return slices.Equal(got, want)
+	})
+}
+
+func TestGraphQLCallsBatchesLoads(t *testing.T) {
+	clientID := int64(50)
+	calls := []entity.CallResponse{
+		{ID: 1, ClientName: "Иван", Status: entity.StatusNew, Priority: "normal", SLAStatus: "ok", ClientID: &clientID},
+		{ID: 2, ClientName: "Иван", Status: entity.StatusInProgress, Priority: "high", SLAStatus: "at_risk", ClientID: &clientID},
+		{ID: 3, ClientName: "Пётр", Status: entity.StatusClosed, Priority: "low", SLAStatus: "ok"},
+	}
+
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("GetUserCalls", mock.Anything, mock.MatchedBy(func(q entity.CallsQuery) bool {
+		return q.UserID == 123 && q.Limit == 3 && q.Status == ""
+	})).Return(&entity.CallsPage{Items: calls}, nil).Once()
+	mockUseCase.On("GetCallsComments", mock.Anything, int64(123), int64(0), sameIDs(1, 2, 3)).Return(map[int64][]entity.Comment{
+		1: {{ID: 10, CallID: 1, AuthorID: 123, Body: "Перезвонить"}, {ID: 11, CallID: 1, AuthorID: 7, Body: "VIP", IsInternal: true}},
+		3: {{ID: 12, CallID: 3, AuthorID: 123, Body: "Закрыт"}},
+	}, nil).Once()
+	mockUseCase.On("GetClientsByIDs", mock.Anything, int64(123), int64(0), sameIDs(50)).Return(map[int64]entity.Client{
+		50: {ID: 50, Name: "Иван", PhoneNumber: "+79991234567", CallsCount: 2},
+	}, nil).Once()
+
+	handler := controller.New(mockUseCase, zerolog.Nop())
+
+	w, resp := postGraphQL(t, handler, graphQLBody(t, `{
+		calls(first: 3) {
+			items {
+				id
+				status
+				allowedStatuses
+				client { id name callsCount }
+				comments(internal: false) { id body }
+			}
+			nextCursor
+		}
+	}`, nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"calls": {"items": [
+		{"id": "1", "status": "new", "allowedStatuses": ["in_progress", "closed"],
+			"client": {"id": "50", "name": "Иван", "callsCount": 2}, "comments": [{"id": "10", "body": "Перезвонить"}]},
+		{"id": "2", "status": "in_progress", "allowedStatuses": ["on_hold", "resolved"],
+			"client": {"id": "50", "name": "Иван", "callsCount": 2}, "comments": []},
+		{"id": "3", "status": "closed", "allowedStatuses": ["reopened"],
+			"client": null, "comments": [{"id": "12", "body": "Закрыт"}]}
+	], "nextCursor": null}}`, string(resp.Data))
+}
+
+func TestGraphQL(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockBehavior  func(*mocks.MockUseCase)
+		expectedCode  int
+		expectedData  string
+		expectedError string
+		expectedExt   map[string]any
+	}{
+		{
+			name: "Current user",
+			body: `{"query": "{ me { id username role } }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("GetUser", mock.Anything, int64(123)).Return(&entity.User{ID: 123, Username: "operator1"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: `{"me": {"id": "123", "username": "operator1", "role": "operator"}}`,
+		},
+		{
+			name: "Call not found",
+			body: `{"query": "{ call(id: 5) { id } }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("GetUserCallByID", mock.Anything, int64(5), int64(123), int64(0)).Return(nil, usecase.ErrCallNotFound)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: `{"call": null}`,
+		},
+		{
+			name: "Create call",
+			body: `{"query": "mutation($input: CreateCallInput!) { createCall(input: $input) { id status } }", "variables": {"input": {"clientName": "Иван", "phoneNumber": "+79991234567", "description": "Не работает интернет"}}}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("SaveCall", mock.Anything, mock.MatchedBy(func(call entity.Call) bool {
+					return call.UserID == 123 && call.PhoneE164 == "+79991234567" && call.Status == entity.StatusNew
+				}), false).Return(int64(9), nil)
+				m.On("GetUserCallByID", mock.Anything, int64(9), int64(123), int64(0)).Return(&entity.CallResponse{ID: 9, Status: entity.StatusNew}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: `{"createCall": {"id": "9", "status": "new"}}`,
+		},
+		{
+			name: "Create duplicate call",
+			body: `{"query": "mutation { createCall(input: {clientName: \"Иван\", phoneNumber: \"+79991234567\", description: \"Не работает интернет\"}) { id } }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("SaveCall", mock.Anything, mock.Anything, false).Return(int64(0), &usecase.DuplicateCallError{CallID: 4})
+			},
+			expectedCode:  http.StatusOK,
+			expectedData:  `null`,
+			expectedError: "Call duplicates an open call",
+			expectedExt:   map[string]any{"code": "CONFLICT", "callId": "4"},
+		},
+		{
+			name:          "Create call with invalid phone",
+			body:          `{"query": "mutation { createCall(input: {clientName: \"Иван\", phoneNumber: \"12\", description: \"Не работает интернет\"}) { id } }"}`,
+			mockBehavior:  func(m *mocks.MockUseCase) {},
+			expectedCode:  http.StatusOK,
+			expectedData:  `null`,
+			expectedError: "Invalid phone number format",
+			expectedExt:   map[string]any{"code": "BAD_USER_INPUT"},
+		},
+		{
+			name: "Update status",
+			body: `{"query": "mutation { updateCallStatus(id: 5, status: resolved) { id status } }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("UpdateCallStatus", mock.Anything, int64(5), int64(123), int64(0), "resolved").Return(nil)
+				m.On("GetUserCallByID", mock.Anything, int64(5), int64(123), int64(0)).Return(&entity.CallResponse{ID: 5, Status: entity.StatusResolved}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: `{"updateCallStatus": {"id": "5", "status": "resolved"}}`,
+		},
+		{
+			name: "Update status with forbidden transition",
+			body: `{"query": "mutation { updateCallStatus(id: 5, status: new) { id } }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("UpdateCallStatus", mock.Anything, int64(5), int64(123), int64(0), "new").
+					Return(&usecase.TransitionError{From: "closed", To: "new", Allowed: []string{"reopened"}})
+			},
+			expectedCode:  http.StatusOK,
+			expectedData:  `null`,
+			expectedError: "Status transition is not allowed",
+			expectedExt:   map[string]any{"code": "CONFLICT", "currentStatus": "closed", "allowedStatuses": []any{"reopened"}},
+		},
+		{
+			name: "Delete missing call",
+			body: `{"query": "mutation { deleteCall(id: 5) }"}`,
+			mockBehavior: func(m *mocks.MockUseCase) {
+				m.On("DeleteCall", mock.Anything, int64(5), int64(123), int64(0)).Return(usecase.ErrCallNotFound)
+			},
+			expectedCode:  http.StatusOK,
+			expectedData:  `null`,
+			expectedError: "Call not found or does not belong to user",
+			expectedExt:   map[string]any{"code": "NOT_FOUND"},
+		},
+		{
+			name:          "Invalid page size",
+			body:          `{"query": "{ calls(first: 500) { nextCursor } }"}`,
+			mockBehavior:  func(m *mocks.MockUseCase) {},
+			expectedCode:  http.StatusOK,
+			expectedData:  `null`,
+			expectedError: "first must be between 1 and 100",
+			expectedExt:   map[string]any{"code": "BAD_USER_INPUT"},
+		},
+		{
+			name:         "Invalid JSON",
+			body:         `{"query": `,
+			mockBehavior: func(m *mocks.MockUseCase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Missing query",
+			body:         `{"variables": {}}`,
+			mockBehavior: func(m *mocks.MockUseCase) {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.mockBehavior(mockUseCase)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			w, resp := postGraphQL(t, handler, tt.body)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+			assert.JSONEq(t, tt.expectedData, string(resp.Data))
+			if tt.expectedError == "" {
+				assert.Empty(t, resp.Errors)
+				return
+			}
+			if assert.Len(t, resp.Errors, 1) {
+				assert.Equal(t, tt.expectedError, resp.Errors[0].Message)
+				assert.Equal(t, tt.expectedExt, resp.Errors[0].Extensions)
+			}
+		})
+	}
+}
+
+func TestGraphQLUnauthorized(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("POST", "/graphql", bytes.NewBufferString(`{"query": "{ me { id } }"}`))
+
+	controller.New(mocks.NewMockUseCase(t), zerolog.Nop()).GraphQL(c)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestGraphQLWebSocket(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	events := make(chan entity.CallStreamEvent, 2)
+	events <- entity.CallStreamEvent{ID: 7, Type: entity.StreamCallUpdated, Event: entity.EventStatusChanged, CallID: 1,
+		Call: &entity.CallResponse{ID: 1, Status: entity.StatusResolved}}
+	events <- entity.CallStreamEvent{ID: 8, Type: entity.StreamCallDeleted, Event: entity.EventDeleted, CallID: 2}
+
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("SubscribeCalls", mock.Anything, int64(123), int64(0)).Return((<-chan entity.CallStreamEvent)(events))
+	mockUseCase.On("GetCallsComments", mock.Anything, int64(123), int64(0), []int64{1}).Return(map[int64][]entity.Comment{}, nil)
+
+	router := gin.New()
+	router.GET("/graphql", middleware.Auth(middleware.QueryToken()), controller.New(mockUseCase, zerolog.Nop()).GraphQLWebSocket)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": 123}).SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/graphql?access_token=" + token
+
+	t.Run("Without subprotocol", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, 4406))
+	})
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+
+	t.Run("Subscribe before init", func(t *testing.T) {
+		conn, _, err := dialer.Dial(url, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"id": "1", "type": "subscribe", "payload": map[string]any{"query": "{ me { id } }"}}))
+
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, 4401))
+	})
+
+	t.Run("Subscription", func(t *testing.T) {
+		conn, _, err := dialer.Dial(url, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		read := func() map[string]any {
+			var msg map[string]any
+			_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+			assert.NoError(t, conn.ReadJSON(&msg))
+			return msg
+		}
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"type": "connection_init"}))
+		assert.Equal(t, map[string]any{"type": "connection_ack"}, read())
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"type": "ping"}))
+		assert.Equal(t, map[string]any{"type": "pong"}, read())
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"id": "s1", "type": "subscribe", "payload": map[string]any{
+			"query": "subscription { callChanged { id type callId call { id status comments { id } } } }",
+		}}))
+
+		assert.Equal(t, map[string]any{"id": "s1", "type": "next", "payload": map[string]any{"data": map[string]any{
+			"callChanged": map[string]any{"id": "7", "type": "updated", "callId": "1",
+				"call": map[string]any{"id": "1", "status": "resolved", "comments": []any{}}},
+		}}}, read())
+		assert.Equal(t, map[string]any{"id": "s1", "type": "next", "payload": map[string]any{"data": map[string]any{
+			"callChanged": map[string]any{"id": "8", "type": "deleted", "callId": "2", "call": nil},
+		}}}, read())
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"id": "q1", "type": "subscribe", "payload": map[string]any{
+			"query": "{ calls { unknownField } }",
+		}}))
+		msg := read()
+		assert.Equal(t, "q1", msg["id"])
+		assert.Equal(t, "error", msg["type"])
+
+		close(events)
+		assert.Equal(t, map[string]any{"id": "s1", "type": "complete"}, read())
+
+		assert.NoError(t, conn.WriteJSON(map[string]any{"type": "connection_init"}))
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, 4429))
+	})
+}
@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"calls-service/rest-service/internal/controller/middleware"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	callspb "calls-service/rest-service/proto"
+
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CallsServer serves the calls API over gRPC with the use case and settings
+// of a CallsHandler. It expects calls authenticated by middleware.UnaryAuth
+// and middleware.StreamAuth.
+type CallsServer struct {
+	callspb.UnimplementedCallsServiceServer
+	h *CallsHandler
+}
+
+func NewCallsServer(h *CallsHandler) *CallsServer {
+	return &CallsServer{h: h}
+}
+
+func (s *CallsServer) Create(ctx context.Context, req *callspb.CreateRequest) (*callspb.Call, error) {
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := entity.CallDTO{
+		ClientName:  req.ClientName,
+		PhoneNumber: req.PhoneNumber,
+		Description: req.Description,
+		Priority:    req.Priority,
+	}
+	if err := binding.Validator.ValidateStruct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid request format")
+	}
+
+	number, err := s.h.phones.Parse(input.PhoneNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid phone number format")
+	}
+
+	newCall := entity.Call{
+		ClientName:  input.ClientName,
+		PhoneNumber: input.PhoneNumber,
+		PhoneE164:   number.E164(),
+		Description: input.Description,
+		Status:      entity.StatusNew,
+		UserID:      userID,
+		OrgID:       orgID,
+		Priority:    input.Priority,
+	}
+
+	callID, err := s.h.u.SaveCall(ctx, newCall, req.Force)
+	if err != nil {
+		var dupErr *usecase.DuplicateCallError
+		if errors.As(err, &dupErr) {
+			return nil, status.Errorf(codes.AlreadyExists, "Call duplicates open call %d", dupErr.CallID)
+		}
+		s.h.l.Error().Err(err).Msg("Failed to save call")
+		return nil, status.Error(codes.Internal, "Failed to save call")
+	}
+
+	s.h.l.Info().Int64("callID", callID).Msg("Call success save")
+
+	call, err := s.h.u.GetUserCallByID(ctx, callID, userID, orgID)
+	if err != nil {
+		s.h.l.Error().Err(err).Msg("Failed to get user call by ID")
+		return nil, status.Error(codes.Internal, "Failed to get user call")
+	}
+
+	return callToProto(call), nil
+}
+
+func (s *CallsServer) Get(ctx context.Context, req *callspb.GetRequest) (*callspb.Call, error) {
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := s.h.u.GetUserCallByID(ctx, req.Id, userID, orgID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return nil, status.Error(codes.NotFound, "Call not found")
+		}
+		s.h.l.Error().Err(err).Msg("Failed to get user call by ID")
+		return nil, status.Error(codes.Internal, "Failed to get user call")
+	}
+
+	return callToProto(call), nil
+}
+
+func (s *CallsServer) List(req *callspb.ListRequest, stream grpc.ServerStreamingServer[callspb.Call]) error {
+	ctx := stream.Context()
+
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	filter := entity.CallsFilterDTO{
+		Status:      req.Status,
+		PhoneNumber: req.PhoneNumber,
+		ClientName:  req.ClientName,
+		AssignedTo:  req.AssignedTo,
+		Priority:    req.Priority,
+		Tag:         req.Tag,
+		SLA:         req.Sla,
+		Sort:        req.Sort,
+		Order:       req.Order,
+	}
+	if req.CreatedFrom != nil {
+		filter.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		filter.CreatedTo = req.CreatedTo.AsTime()
+	}
+	if err := binding.Validator.ValidateStruct(filter); err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid request format")
+	}
+
+	var sent int
+	err = s.h.u.ExportCalls(ctx, callsQuery(userID, orgID, filter), func(call entity.CallResponse) error {
+		sent++
+		return stream.Send(callToProto(&call))
+	})
+	if err != nil {
+		s.h.l.Error().Err(err).Int("sent", sent).Msg("Failed to list calls")
+		return status.Error(codes.Internal, "Failed to list calls")
+	}
+
+	return nil
+}
+
+func (s *CallsServer) UpdateStatus(ctx context.Context, req *callspb.UpdateStatusRequest) (*emptypb.Empty, error) {
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usecase.IsKnownStatus(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid status value")
+	}
+
+	if err := s.h.u.UpdateCallStatus(ctx, req.Id, userID, orgID, req.Status); err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return nil, status.Error(codes.NotFound, "Call not found or does not belong to user")
+		}
+		var transitionErr *usecase.TransitionError
+		if errors.As(err, &transitionErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Status transition is not allowed, call is %s and may become: %s",
+				transitionErr.From, strings.Join(transitionErr.Allowed, ", "))
+		}
+		if errors.Is(err, usecase.ErrStatusChanged) {
+			return nil, status.Error(codes.Aborted, "Call status was changed by another request")
+		}
+		s.h.l.Error().Err(err).Msg("Failed to update call status")
+		return nil, status.Error(codes.Internal, "Failed to update call status")
+	}
+
+	s.h.l.Info().Int64("callID", req.Id).Msg("Call success update")
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CallsServer) Delete(ctx context.Context, req *callspb.DeleteRequest) (*emptypb.Empty, error) {
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.h.u.DeleteCall(ctx, req.Id, userID, orgID); err != nil {
+		if errors.Is(err, usecase.ErrCallNotFound) {
+			return nil, status.Error(codes.NotFound, "Call not found or does not belong to user")
+		}
+		s.h.l.Error().Err(err).Msg("Failed to delete call")
+		return nil, status.Error(codes.Internal, "Failed to delete call")
+	}
+
+	s.h.l.Info().Int64("callID", req.Id).Msg("Call success deleted")
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CallsServer) Watch(_ *callspb.WatchRequest, stream grpc.ServerStreamingServer[callspb.CallEvent]) error {
+	ctx := stream.Context()
+
+	userID, orgID, err := grpcUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	for e := range s.h.u.SubscribeCalls(ctx, userID, orgID) {
+		if err := stream.Send(callEventToProto(e)); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return status.Error(codes.Unavailable, "Stream closed, reload the calls and watch again")
+}
+
+// grpcUser returns the user a gRPC call was authenticated as and the
+// organization of the session.
+func grpcUser(ctx context.Context) (int64, int64, error) {
+	identity, ok := middleware.IdentityFromContext(ctx)
+	if !ok {
+		return 0, 0, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	return identity.UserID, identity.OrgID, nil
+}
+
+func callToProto(call *entity.CallResponse) *callspb.Call {
+	return &callspb.Call{
+		Id:               call.ID,
+		ClientName:       call.ClientName,
+		PhoneNumber:      call.PhoneNumber,
+		PhoneE164:        call.PhoneE164,
+		PhoneNational:    call.PhoneNational,
+		Description:      call.Description,
+		Status:           call.Status,
+		StatusLabel:      call.StatusLabel,
+		CreatedAt:        timestamppb.New(call.CreatedAt),
+		UpdatedAt:        timestamppb.New(call.UpdatedAt),
+		Version:          call.Version,
+		AssigneeId:       call.AssigneeID,
+		Priority:         call.Priority,
+		DueAt:            timestampToProto(call.DueAt),
+		SlaStatus:        call.SLAStatus,
+		ClosedAt:         timestampToProto(call.ClosedAt),
+		Tags:             call.Tags,
+		ClientId:         call.ClientID,
+		CallbackAt:       timestampToProto(call.CallbackAt),
+		CallbackTimezone: call.CallbackTimezone,
+	}
+}
+
+func callEventToProto(e entity.CallStreamEvent) *callspb.CallEvent {
+	event := &callspb.CallEvent{
+		Id:     e.ID,
+		Type:   e.Type,
+		Event:  e.Event,
+		CallId: e.CallID,
+	}
+	if e.Call != nil {
+		event.Call = callToProto(e.Call)
+	}
+	return event
+}
+
+func timestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,392 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/middleware"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	callspb "calls-service/rest-service/proto"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newCallsClient serves the gRPC calls API with u in memory.
+func newCallsClient(t *testing.T, u usecase.UseCase) callspb.CallsServiceClient {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(middleware.UnaryAuth()),
+		grpc.ChainStreamInterceptor(middleware.StreamAuth()),
+	)
+	callspb.RegisterCallsServiceServer(server, controller.NewCallsServer(controller.New(u, zerolog.Nop())))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return callspb.NewCallsServiceClient(conn)
+}
+
+func withToken(t *testing.T, claims jwt.MapClaims) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func assertStatus(t *testing.T, err error, code codes.Code, msg string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if assert.True(t, ok, "not a status error: %v", err) {
+		assert.Equal(t, code, st.Code())
+		assert.Equal(t, msg, st.Message())
+	}
+}
+
+func TestCallsServerAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         func(t *testing.T) context.Context
+		expectedMsg string
+	}{
+		{
+			name:        "Missing token",
+			ctx:         func(*testing.T) context.Context { return context.Background() },
+			expectedMsg: "Authorization header is missing",
+		},
+		{
+			name: "Invalid header format",
+			ctx: func(*testing.T) context.Context {
+				return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Token abc")
+			},
+			expectedMsg: "Invalid authorization header format",
+		},
+		{
+			name: "Invalid token",
+			ctx: func(*testing.T) context.Context {
+				return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer abc")
+			},
+			expectedMsg: "Invalid token",
+		},
+		{
+			name:        "Missing user ID",
+			ctx:         func(t *testing.T) context.Context { return withToken(t, jwt.MapClaims{"role": "operator"}) },
+			expectedMsg: "Invalid token claims",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newCallsClient(t, mocks.NewMockUseCase(t))
+
+			_, err := client.Get(tt.ctx(t), &callspb.GetRequest{Id: 1})
+			assertStatus(t, err, codes.Unauthenticated, tt.expectedMsg)
+
+			stream, err := client.Watch(tt.ctx(t), &callspb.WatchRequest{})
+			assert.NoError(t, err)
+			_, err = stream.Recv()
+			assertStatus(t, err, codes.Unauthenticated, tt.expectedMsg)
+		})
+	}
+}
+
+func TestCallsServerCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *callspb.CreateRequest
+		mockSaveErr  error
+		shouldSave   bool
+		expectedCode codes.Code
+		expectedMsg  string
+	}{
+		{
+			name:         "Success",
+			req:          &callspb.CreateRequest{ClientName: "Ivan", PhoneNumber: "+79876543211", Description: "Help", Priority: "high"},
+			shouldSave:   true,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Missing description",
+			req:          &callspb.CreateRequest{ClientName: "Ivan", PhoneNumber: "+79876543211"},
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "Invalid request format",
+		},
+		{
+			name:         "Invalid phone number",
+			req:          &callspb.CreateRequest{ClientName: "Ivan", PhoneNumber: "123", Description: "Help"},
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "Invalid phone number format",
+		},
+		{
+			name:         "Duplicate",
+			req:          &callspb.CreateRequest{ClientName: "Ivan", PhoneNumber: "+79876543211", Description: "Help"},
+			mockSaveErr:  &usecase.DuplicateCallError{CallID: 7},
+			shouldSave:   true,
+			expectedCode: codes.AlreadyExists,
+			expectedMsg:  "Call duplicates open call 7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldSave {
+				mockUseCase.On("SaveCall", mock.Anything, mock.MatchedBy(func(call entity.Call) bool {
+					return call.UserID == 123 && call.PhoneE164 == "+79876543211" && call.Status == entity.StatusNew
+				}), false).Return(int64(42), tt.mockSaveErr)
+			}
+			if tt.expectedCode == codes.OK {
+				mockUseCase.On("GetUserCallByID", mock.Anything, int64(42), int64(123), int64(0)).
+					Return(&entity.CallResponse{ID: 42, ClientName: "Ivan", Status: entity.StatusNew, Priority: "high"}, nil)
+			}
+
+			client := newCallsClient(t, mockUseCase)
+
+			call, err := client.Create(withToken(t, jwt.MapClaims{"id": 123}), tt.req)
+			if tt.expectedCode != codes.OK {
+				assertStatus(t, err, tt.expectedCode, tt.expectedMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(42), call.Id)
+			assert.Equal(t, "high", call.Priority)
+		})
+	}
+}
+
+func TestCallsServerGet(t *testing.T) {
+	mockUseCase := mocks.NewMockUseCase(t)
+	assigneeID := int64(5)
+	mockUseCase.On("GetUserCallByID", mock.Anything, int64(1), int64(123), int64(0)).
+		Return(&entity.CallResponse{ID: 1, AssigneeID: &assigneeID, Tags: []string{"vip"}}, nil)
+	mockUseCase.On("GetUserCallByID", mock.Anything, int64(2), int64(123), int64(0)).
+		Return(nil, usecase.ErrCallNotFound)
+
+	client := newCallsClient(t, mockUseCase)
+	ctx := withToken(t, jwt.MapClaims{"id": 123})
+
+	call, err := client.Get(ctx, &callspb.GetRequest{Id: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), call.Id)
+	assert.Equal(t, int64(5), call.GetAssigneeId())
+	assert.Nil(t, call.ClientId)
+	assert.Nil(t, call.DueAt)
+	assert.Equal(t, []string{"vip"}, call.Tags)
+
+	_, err = client.Get(ctx, &callspb.GetRequest{Id: 2})
+	assertStatus(t, err, codes.NotFound, "Call not found")
+}
+
+func TestCallsServerList(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *callspb.ListRequest
+		shouldExport  bool
+		mockExportErr error
+		expectedIDs   []int64
+		expectedCode  codes.Code
+		expectedMsg   string
+	}{
+		{
+			name:         "Success",
+			req:          &callspb.ListRequest{Status: entity.StatusNew, AssignedTo: "me", Sort: "id", Order: "asc"},
+			shouldExport: true,
+			expectedIDs:  []int64{1, 2},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Invalid sort",
+			req:          &callspb.ListRequest{Sort: "phone"},
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "Invalid request format",
+		},
+		{
+			name:          "Export error",
+			req:           &callspb.ListRequest{},
+			shouldExport:  true,
+			mockExportErr: errors.New("database error"),
+			expectedIDs:   []int64{1, 2},
+			expectedCode:  codes.Internal,
+			expectedMsg:   "Failed to list calls",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldExport {
+				mockUseCase.On("ExportCalls", mock.Anything, mock.MatchedBy(func(q entity.CallsQuery) bool {
+					return q.UserID == 123 && q.Status == tt.req.Status && q.SortBy == tt.req.Sort &&
+						(tt.req.AssignedTo != "me" || q.AssigneeID == 123)
+				}), mock.Anything).
+					Run(func(args mock.Arguments) {
+						fn := args.Get(2).(func(entity.CallResponse) error)
+						_ = fn(entity.CallResponse{ID: 1})
+						_ = fn(entity.CallResponse{ID: 2})
+					}).
+					Return(tt.mockExportErr)
+			}
+
+			client := newCallsClient(t, mockUseCase)
+
+			stream, err := client.List(withToken(t, jwt.MapClaims{"id": 123}), tt.req)
+			assert.NoError(t, err)
+
+			var ids []int64
+			for {
+				call, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					assertStatus(t, err, tt.expectedCode, tt.expectedMsg)
+					break
+				}
+				ids = append(ids, call.Id)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestCallsServerUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		mockErr      error
+		shouldCall   bool
+		expectedCode codes.Code
+		expectedMsg  string
+	}{
+		{
+			name:         "Success",
+			status:       entity.StatusInProgress,
+			shouldCall:   true,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Unknown status",
+			status:       "lost",
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "Invalid status value",
+		},
+		{
+			name:         "Not found",
+			status:       entity.StatusInProgress,
+			mockErr:      usecase.ErrCallNotFound,
+			shouldCall:   true,
+			expectedCode: codes.NotFound,
+			expectedMsg:  "Call not found or does not belong to user",
+		},
+		{
+			name:   "Transition not allowed",
+			status: entity.StatusInProgress,
+			mockErr: &usecase.TransitionError{
+				From:    entity.StatusClosed,
+				To:      entity.StatusInProgress,
+				Allowed: []string{entity.StatusReopened},
+			},
+			shouldCall:   true,
+			expectedCode: codes.FailedPrecondition,
+			expectedMsg:  "Status transition is not allowed, call is closed and may become: reopened",
+		},
+		{
+			name:         "Status changed concurrently",
+			status:       entity.StatusInProgress,
+			mockErr:      usecase.ErrStatusChanged,
+			shouldCall:   true,
+			expectedCode: codes.Aborted,
+			expectedMsg:  "Call status was changed by another request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCall {
+				mockUseCase.On("UpdateCallStatus", mock.Anything, int64(1), int64(123), int64(0), tt.status).Return(tt.mockErr)
+			}
+
+			client := newCallsClient(t, mockUseCase)
+
+			_, err := client.UpdateStatus(withToken(t, jwt.MapClaims{"id": 123}), &callspb.UpdateStatusRequest{Id: 1, Status: tt.status})
+			if tt.expectedCode == codes.OK {
+				assert.NoError(t, err)
+				return
+			}
+			assertStatus(t, err, tt.expectedCode, tt.expectedMsg)
+		})
+	}
+}
+
+func TestCallsServerDelete(t *testing.T) {
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("DeleteCall", mock.Anything, int64(1), int64(123), int64(0)).Return(nil)
+	mockUseCase.On("DeleteCall", mock.Anything, int64(2), int64(123), int64(0)).Return(usecase.ErrCallNotFound)
+
+	client := newCallsClient(t, mockUseCase)
+	ctx := withToken(t, jwt.MapClaims{"id": 123})
+
+	_, err := client.Delete(ctx, &callspb.DeleteRequest{Id: 1})
+	assert.NoError(t, err)
+
+	_, err = client.Delete(ctx, &callspb.DeleteRequest{Id: 2})
+	assertStatus(t, err, codes.NotFound, "Call not found or does not belong to user")
+}
+
+func TestCallsServerWatch(t *testing.T) {
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("SubscribeCalls", mock.Anything, int64(123), int64(0)).Return(streamEvents(
+		entity.CallStreamEvent{ID: 7, Type: entity.StreamCallCreated, Event: entity.EventCreated, CallID: 1, Call: &entity.CallResponse{ID: 1}},
+		entity.CallStreamEvent{ID: 8, Type: entity.StreamCallDeleted, Event: entity.EventDeleted, CallID: 1},
+	))
+
+	client := newCallsClient(t, mockUseCase)
+
+	stream, err := client.Watch(withToken(t, jwt.MapClaims{"id": 123}), &callspb.WatchRequest{})
+	assert.NoError(t, err)
+
+	e, err := stream.Recv()
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(7), e.Id)
+		assert.Equal(t, entity.StreamCallCreated, e.Type)
+		assert.Equal(t, int64(1), e.Call.GetId())
+	}
+
+	e, err = stream.Recv()
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(8), e.Id)
+		assert.Equal(t, entity.StreamCallDeleted, e.Type)
+		assert.Nil(t, e.Call)
+	}
+
+	// The events run out as when the stream falls behind.
+	_, err = stream.Recv()
+	assertStatus(t, err, codes.Unavailable, "Stream closed, reload the calls and watch again")
+}
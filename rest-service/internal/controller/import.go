@@ -0,0 +1,496 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"calls-service/pkg/phone"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// maxImportSize limits the whole multipart request of an import.
+	maxImportSize = 32 << 20
+	// maxImportErrors limits the row errors listed in an import report; rows
+	// past the limit are still counted as failed.
+	maxImportErrors = 1000
+)
+
+// importFields lists the call fields an import file may contain. The first
+// three are required.
+var importFields = []string{
+	entity.ImportClientName,
+	entity.ImportPhoneNumber,
+	entity.ImportDescription,
+	entity.ImportPriority,
+	entity.ImportStatus,
+	entity.ImportCreatedAt,
+}
+
+// importDTOFields maps CallDTO fields to import fields to report validation errors.
+var importDTOFields = map[string]string{
+	"ClientName":  entity.ImportClientName,
+	"PhoneNumber": entity.ImportPhoneNumber,
+	"Description": entity.ImportDescription,
+	"Priority":    entity.ImportPriority,
+}
+
+// importDateLayouts are the accepted formats of created_at. Times without a
+// zone are taken as UTC.
+var importDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+	"02.01.2006",
+}
+
+// ImportCalls creates calls from a CSV or XLSX file.
+//
+// @Summary Import calls
+// @Description Imports calls from a CSV or XLSX file (first sheet) with a header row. Every row is validated like POST /calls; invalid rows are skipped and listed in the report, valid rows are imported in one transaction. Unless force is set, rows repeating a recent open call or an earlier row of the file are skipped as duplicates and listed in the report too. The optional columns are priority, status (status code) and created_at (YYYY-MM-DD[ HH:MM[:SS]], DD.MM.YYYY[ HH:MM[:SS]] or RFC 3339). In dry-run mode the file is only validated
+// @Tags import
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV or XLSX file"
+// @Param mapping formData string false "JSON object mapping call fields to column headers, e.g. {\"client_name\":\"ФИО\"}; unmapped fields are looked up by their own name"
+// @Param delimiter formData string false "CSV field delimiter, comma by default"
+// @Param dry_run formData bool false "Validate the file without importing it"
+// @Param force formData bool false "Import rows repeating a recent open call as well"
+// @Success 200 {object} entity.ImportReport "Import report"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 413 {object} apierrors.Response "File is too large"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/import [post]
+func (h *CallsHandler) ImportCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var input entity.ImportCallsDTO
+	if err := c.ShouldBind(&input); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, apierrors.Response{Error: "File is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	mapping := map[string]string{}
+	if input.Mapping != "" {
+		if err := json.Unmarshal([]byte(input.Mapping), &mapping); err != nil {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid column mapping"})
+			return
+		}
+	}
+	for field, header := range mapping {
+		if !slices.Contains(importFields, field) || header == "" {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid column mapping"})
+			return
+		}
+	}
+
+	file, err := input.File.Open()
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to open import file")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to import calls"})
+		return
+	}
+	defer file.Close()
+
+	var rows rowReader
+	format := strings.ToLower(filepath.Ext(input.File.Filename))
+	switch format {
+	case ".csv":
+		r := csv.NewReader(file)
+		r.FieldsPerRecord = -1
+		if input.Delimiter != "" {
+			d, size := utf8.DecodeRuneInString(input.Delimiter)
+			if size != len(input.Delimiter) || d == '"' || d == '\r' || d == '\n' {
+				c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid delimiter"})
+				return
+			}
+			r.Comma = d
+		}
+		rows = &csvRows{r: r}
+	case ".xlsx":
+		xr, err := newXLSXRows(file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Failed to read file"})
+			return
+		}
+		defer xr.Close()
+		rows = xr
+	default:
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Unsupported file format, expected CSV or XLSX"})
+		return
+	}
+
+	src, err := newImportSource(rows, mapping, userID, orgID, h.phones)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid file header: " + err.Error()})
+		return
+	}
+	src.serialDates = format == ".xlsx"
+
+	res, err := h.u.ImportCalls(c.Request.Context(), src, input.DryRun, input.Force)
+	if err != nil {
+		if src.err != nil {
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Failed to read file: " + src.err.Error()})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to import calls")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to import calls"})
+		return
+	}
+
+	report := src.report
+	report.DryRun = input.DryRun
+	if !input.DryRun {
+		report.Imported = res.Imported
+		report.Duplicates = len(res.Duplicates)
+		for _, dup := range res.Duplicates {
+			if len(report.Errors) < maxImportErrors {
+				report.Errors = append(report.Errors, src.rowError(dup.Row, entity.ImportPhoneNumber, fmt.Sprintf("Duplicate of call %d", dup.CallID)))
+			}
+		}
+		slices.SortStableFunc(report.Errors, func(a, b entity.ImportRowError) int {
+			return a.Row - b.Row
+		})
+	}
+
+	h.l.Info().Bool("dry_run", report.DryRun).Int("rows", report.TotalRows).Int64("imported", report.Imported).Int("duplicates", report.Duplicates).Int("failed", report.Failed).Msg("Calls imported")
+
+	c.JSON(http.StatusOK, report)
+}
+
+// rowReader reads the rows of an import file one by one together with their
+// line numbers. It returns io.EOF after the last row.
+type rowReader interface {
+	Read() (record []string, line int, err error)
+}
+
+type csvRows struct {
+	r *csv.Reader
+}
+
+func (r *csvRows) Read() ([]string, int, error) {
+	record, err := r.r.Read()
+	if err != nil {
+		return nil, 0, err
+	}
+	line, _ := r.r.FieldPos(0)
+	return record, line, nil
+}
+
+// xlsxRows streams the rows of the first sheet of a workbook. Cells are read
+// raw, so dates come as Excel serial numbers.
+type xlsxRows struct {
+	f    *excelize.File
+	rows *excelize.Rows
+	line int
+}
+
+func newXLSXRows(r io.Reader) (*xlsxRows, error) {
+	f, err := excelize.OpenReader(r, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, err
+	}
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		f.Close()
+		return nil, errors.New("workbook has no sheets")
+	}
+
+	rows, err := f.Rows(sheets[0])
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &xlsxRows{f: f, rows: rows}, nil
+}
+
+func (r *xlsxRows) Read() ([]string, int, error) {
+	if !r.rows.Next() {
+		if err := r.rows.Error(); err != nil {
+			return nil, 0, err
+		}
+		return nil, 0, io.EOF
+	}
+	r.line++
+
+	record, err := r.rows.Columns()
+	if err != nil {
+		return nil, 0, err
+	}
+	return record, r.line, nil
+}
+
+func (r *xlsxRows) Close() error {
+	r.rows.Close()
+	return r.f.Close()
+}
+
+// importSource validates the rows of an import file and yields the valid
+// ones as calls of userID. Invalid rows end up in the report.
+type importSource struct {
+	rows    rowReader
+	columns map[string]int
+	headers []string
+	userID  int64
+	orgID   int64
+	phones  *phone.Parser
+	// serialDates accepts Excel serial numbers as dates.
+	serialDates bool
+
+	call   entity.Call
+	line   int
+	err    error
+	report entity.ImportReport
+}
+
+// newImportSource reads the header row and finds the column of every call
+// field. A field mapped to a missing column is an error, as is a missing
+// required column.
+func newImportSource(rows rowReader, mapping map[string]string, userID, orgID int64, phones *phone.Parser) (*importSource, error) {
+	headers, _, err := rows.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(headers))
+	for i, header := range headers {
+		header = strings.TrimSpace(strings.TrimPrefix(header, "\ufeff"))
+		headers[i] = header
+		if _, ok := index[header]; !ok {
+			index[header] = i
+		}
+	}
+
+	columns := make(map[string]int, len(importFields))
+	for i, field := range importFields {
+		header, mapped := mapping[field]
+		if !mapped {
+			header = field
+		}
+		col, ok := index[header]
+		if !ok {
+			if mapped || i < 3 {
+				return nil, fmt.Errorf("column %q not found", header)
+			}
+			continue
+		}
+		columns[field] = col
+	}
+
+	return &importSource{
+		rows:    rows,
+		columns: columns,
+		headers: headers,
+		userID:  userID,
+		orgID:   orgID,
+		phones:  phones,
+		report:  entity.ImportReport{Errors: []entity.ImportRowError{}},
+	}, nil
+}
+
+func (s *importSource) Next() bool {
+	for s.err == nil {
+		record, line, err := s.rows.Read()
+		if errors.Is(err, io.EOF) {
+			return false
+		}
+		if err != nil {
+			s.err = err
+			return false
+		}
+		if isBlankRow(record) {
+			continue
+		}
+
+		s.report.TotalRows++
+		call, rowErrs := s.parse(record, line)
+		if len(rowErrs) > 0 {
+			s.report.Failed++
+			for _, e := range rowErrs {
+				if len(s.report.Errors) < maxImportErrors {
+					s.report.Errors = append(s.report.Errors, e)
+				}
+			}
+			continue
+		}
+
+		s.report.Valid++
+		s.call = call
+		s.line = line
+		return true
+	}
+	return false
+}
+
+func (s *importSource) Call() entity.Call {
+	return s.call
+}
+
+func (s *importSource) Row() int {
+	return s.line
+}
+
+func (s *importSource) Err() error {
+	return s.err
+}
+
+func (s *importSource) rowError(line int, field, msg string) entity.ImportRowError {
+	return entity.ImportRowError{Row: line, Column: s.headers[s.columns[field]], Error: msg}
+}
+
+// parse checks a row the way SaveCall checks a new call and returns either
+// the call or the errors found in the row.
+func (s *importSource) parse(record []string, line int) (entity.Call, []entity.ImportRowError) {
+	value := func(field string) string {
+		col, ok := s.columns[field]
+		if !ok || col >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[col])
+	}
+	rowErr := func(field, msg string) entity.ImportRowError {
+		return s.rowError(line, field, msg)
+	}
+
+	dto := entity.CallDTO{
+		ClientName:  value(entity.ImportClientName),
+		PhoneNumber: value(entity.ImportPhoneNumber),
+		Description: value(entity.ImportDescription),
+		Priority:    value(entity.ImportPriority),
+	}
+
+	var errs []entity.ImportRowError
+	if err := binding.Validator.ValidateStruct(&dto); err != nil {
+		var verrs validator.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return entity.Call{}, []entity.ImportRowError{{Row: line, Error: "Invalid row"}}
+		}
+		for _, fe := range verrs {
+			errs = append(errs, rowErr(importDTOFields[fe.StructField()], validationMessage(fe)))
+		}
+	}
+
+	var number phone.Number
+	if dto.PhoneNumber != "" {
+		var err error
+		if number, err = s.phones.Parse(dto.PhoneNumber); err != nil {
+			errs = append(errs, rowErr(entity.ImportPhoneNumber, "Invalid phone number format"))
+		}
+	}
+
+	status := value(entity.ImportStatus)
+	if status != "" && !usecase.IsKnownStatus(status) {
+		errs = append(errs, rowErr(entity.ImportStatus, "Unknown status"))
+	}
+
+	var createdAt time.Time
+	if v := value(entity.ImportCreatedAt); v != "" {
+		t, err := parseImportDate(v, s.serialDates)
+		switch {
+		case err != nil:
+			errs = append(errs, rowErr(entity.ImportCreatedAt, "Invalid date"))
+		case t.After(time.Now()):
+			errs = append(errs, rowErr(entity.ImportCreatedAt, "Date is in the future"))
+		default:
+			createdAt = t
+		}
+	}
+
+	if len(errs) > 0 {
+		return entity.Call{}, errs
+	}
+
+	return entity.Call{
+		ClientName:  dto.ClientName,
+		PhoneNumber: dto.PhoneNumber,
+		PhoneE164:   number.E164(),
+		Description: dto.Description,
+		Status:      status,
+		CreatedAt:   createdAt,
+		UserID:      s.userID,
+		OrgID:       s.orgID,
+		Priority:    dto.Priority,
+	}, nil
+}
+
+// parseImportDate parses v in one of importDateLayouts or, for XLSX files, as
+// an Excel serial date.
+func parseImportDate(v string, serial bool) (time.Time, error) {
+	for _, layout := range importDateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	if serial {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return excelize.ExcelDateToTime(f, false)
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", v)
+}
+
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "Value is required"
+	case "oneof":
+		return "Value must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	default:
+		return "Invalid value"
+	}
+}
+
+func isBlankRow(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,311 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestImportCalls(t *testing.T) {
+	csvFile := "ФИО;Телефон;description;status;created_at\n" +
+		"John Doe;+79876543211;Test call;closed;2024-03-01 10:00\n" +
+		"Jane Doe;invalid-phone;Test call;;\n" +
+		"\n" +
+		";+79876543212;Test call;archived;01.03.2024\n" +
+		"Jim Doe;+79876543213;Test call;;tomorrow\n"
+	mapping := `{"client_name":"ФИО","phone_number":"Телефон"}`
+
+	xlsxFile := func() []byte {
+		f := excelize.NewFile()
+		sheet := f.GetSheetName(0)
+		f.SetSheetRow(sheet, "A1", &[]any{"client_name", "phone_number", "description", "priority", "created_at"})
+		f.SetSheetRow(sheet, "A2", &[]any{"John Doe", "+79876543211", "Test call", "high", 45352.5})
+		f.SetSheetRow(sheet, "A3", &[]any{"Jane Doe", "+79876543212", "Test call", "urgent"})
+		buf, _ := f.WriteToBuffer()
+		return buf.Bytes()
+	}()
+
+	csvErrors := []entity.ImportRowError{
+		{Row: 3, Column: "Телефон", Error: "Invalid phone number format"},
+		{Row: 5, Column: "ФИО", Error: "Value is required"},
+		{Row: 5, Column: "status", Error: "Unknown status"},
+		{Row: 6, Column: "created_at", Error: "Invalid date"},
+	}
+
+	tests := []struct {
+		name             string
+		filename         string
+		file             []byte
+		fields           map[string]string
+		expectedCalls    []entity.Call
+		expectedDryRun   bool
+		expectedForce    bool
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:     "Successful CSV import",
+			filename: "calls.csv",
+			file:     []byte(csvFile),
+			fields:   map[string]string{"mapping": mapping, "delimiter": ";"},
+			expectedCalls: []entity.Call{{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				PhoneE164:   "+79876543211",
+				Description: "Test call",
+				Status:      entity.StatusClosed,
+				CreatedAt:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				UserID:      123,
+			}},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.ImportReport{
+				TotalRows: 4,
+				Valid:     1,
+				Imported:  1,
+				Failed:    3,
+				Errors:    csvErrors,
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:     "Dry run",
+			filename: "calls.CSV",
+			file:     []byte(csvFile),
+			fields:   map[string]string{"mapping": mapping, "delimiter": ";", "dry_run": "true"},
+			expectedCalls: []entity.Call{{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				PhoneE164:   "+79876543211",
+				Description: "Test call",
+				Status:      entity.StatusClosed,
+				CreatedAt:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				UserID:      123,
+			}},
+			expectedDryRun: true,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.ImportReport{
+				DryRun:    true,
+				TotalRows: 4,
+				Valid:     1,
+				Failed:    3,
+				Errors:    csvErrors,
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:     "Duplicate rows",
+			filename: "calls.csv",
+			file: []byte("client_name,phone_number,description\n" +
+				"John Doe,+79876543211,Test call\n" +
+				"Jane Doe,invalid-phone,Test call\n" +
+				"John Doe,8 987 654-32-11,Test call\n"),
+			expectedCalls: []entity.Call{
+				{ClientName: "John Doe", PhoneNumber: "+79876543211", PhoneE164: "+79876543211", Description: "Test call", UserID: 123},
+				{ClientName: "John Doe", PhoneNumber: "8 987 654-32-11", PhoneE164: "+79876543211", Description: "Test call", UserID: 123},
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.ImportReport{
+				TotalRows:  3,
+				Valid:      2,
+				Imported:   1,
+				Duplicates: 1,
+				Failed:     1,
+				Errors: []entity.ImportRowError{
+					{Row: 3, Column: "phone_number", Error: "Invalid phone number format"},
+					{Row: 4, Column: "phone_number", Error: "Duplicate of call 1"},
+				},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:     "Forced duplicate rows",
+			filename: "calls.csv",
+			file: []byte("client_name,phone_number,description\n" +
+				"John Doe,+79876543211,Test call\n" +
+				"John Doe,+79876543211,Test call\n"),
+			fields: map[string]string{"force": "true"},
+			expectedCalls: []entity.Call{
+				{ClientName: "John Doe", PhoneNumber: "+79876543211", PhoneE164: "+79876543211", Description: "Test call", UserID: 123},
+				{ClientName: "John Doe", PhoneNumber: "+79876543211", PhoneE164: "+79876543211", Description: "Test call", UserID: 123},
+			},
+			expectedForce:  true,
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.ImportReport{
+				TotalRows: 2,
+				Valid:     2,
+				Imported:  2,
+				Errors:    []entity.ImportRowError{},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:     "Successful XLSX import",
+			filename: "calls.xlsx",
+			file:     xlsxFile,
+			expectedCalls: []entity.Call{{
+				ClientName:  "John Doe",
+				PhoneNumber: "+79876543211",
+				PhoneE164:   "+79876543211",
+				Description: "Test call",
+				CreatedAt:   time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+				UserID:      123,
+				Priority:    entity.PriorityHigh,
+			}},
+			expectedStatus: http.StatusOK,
+			expectedResponse: entity.ImportReport{
+				TotalRows: 2,
+				Valid:     1,
+				Imported:  1,
+				Failed:    1,
+				Errors:    []entity.ImportRowError{{Row: 3, Column: "priority", Error: "Value must be one of: low, normal, high, critical"}},
+			},
+			shouldCallMock: true,
+		},
+		{
+			name:             "Missing required column",
+			filename:         "calls.csv",
+			file:             []byte("client_name,description\nJohn Doe,Test call\n"),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: `Invalid file header: column "phone_number" not found`},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Unknown field in mapping",
+			filename:         "calls.csv",
+			file:             []byte(csvFile),
+			fields:           map[string]string{"mapping": `{"user_id":"ФИО"}`},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid column mapping"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Unsupported format",
+			filename:         "calls.txt",
+			file:             []byte(csvFile),
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Unsupported file format, expected CSV or XLSX"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Missing file",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			filename:         "calls.csv",
+			file:             []byte("client_name,phone_number,description\nJohn Doe,+79876543211,Test call\n"),
+			expectedCalls:    []entity.Call{{ClientName: "John Doe", PhoneNumber: "+79876543211", PhoneE164: "+79876543211", Description: "Test call", UserID: 123}},
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to import calls"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			var calls []entity.Call
+			if tt.shouldCallMock {
+				mockUseCase.On("ImportCalls", mock.Anything, mock.Anything, tt.expectedDryRun, tt.expectedForce).
+					Return(func(_ context.Context, src entity.CallSource, dryRun, force bool) (*entity.ImportResult, error) {
+						// Calls get IDs from 1 in file order and a call
+						// repeating an earlier number is a duplicate of it.
+						res := &entity.ImportResult{}
+						ids := map[string]int64{}
+						for src.Next() {
+							call := src.Call()
+							calls = append(calls, call)
+							if id, ok := ids[call.PhoneE164]; ok && !force {
+								res.Duplicates = append(res.Duplicates, entity.ImportDuplicate{Row: src.Row(), CallID: id})
+								continue
+							}
+							res.Imported++
+							ids[call.PhoneE164] = res.Imported
+						}
+						if tt.mockErr != nil {
+							return nil, tt.mockErr
+						}
+						return res, src.Err()
+					})
+			}
+
+			var body bytes.Buffer
+			mw := multipart.NewWriter(&body)
+			for name, value := range tt.fields {
+				mw.WriteField(name, value)
+			}
+			if tt.filename != "" {
+				fw, _ := mw.CreateFormFile("file", tt.filename)
+				fw.Write(tt.file)
+			}
+			mw.Close()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/calls/import", &body)
+			c.Request.Header.Set("Content-Type", mw.FormDataContentType())
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.ImportCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.ImportReport
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if tt.shouldCallMock {
+				assert.Equal(t, tt.expectedCalls, calls)
+			} else {
+				mockUseCase.AssertNotCalled(t, "ImportCalls")
+			}
+		})
+	}
+}
+
+func TestImportCallsUnauthorized(t *testing.T) {
+	mockUseCase := mocks.NewMockUseCase(t)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("POST", "/calls/import", nil)
+
+	handler := controller.New(mockUseCase, zerolog.Nop())
+
+	handler.ImportCalls(c)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockUseCase.AssertNotCalled(t, "ImportCalls")
+}
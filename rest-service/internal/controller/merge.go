@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MergeCalls folds duplicate calls into a call.
+//
+// @Summary Merge duplicate calls
+// @Description Moves the history, comments, attachments and tags of duplicate calls to the call and moves the duplicates to the trash, from which they cannot be restored. All calls must be active and visible to the authenticated user, and the duplicates must have the number, the client and the organization of the call
+// @Tags calls
+// @Accept json
+// @Produce json
+// @Param id path int true "Surviving call ID"
+// @Param input body entity.MergeCallsDTO true "Duplicate calls (up to 20)"
+// @Success 200 {object} entity.CallResponse "Surviving call"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call or duplicate not found"
+// @Failure 409 {object} apierrors.Response "Duplicate belongs to a different organization"
+// @Failure 422 {object} apierrors.Response "Duplicate has a different number or client"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/merge [post]
+func (h *CallsHandler) MergeCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.MergeCallsDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	call, err := h.u.MergeCalls(c.Request.Context(), entity.CallMerge{CallID: callID, UserID: userID, OrgID: orgID, DuplicateIDs: input.DuplicateIDs})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrMergeIntoItself):
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Call cannot be merged into itself"})
+		case errors.Is(err, usecase.ErrCallNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found or does not belong to user"})
+		case errors.Is(err, usecase.ErrDuplicateNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Duplicate call not found"})
+		case errors.Is(err, usecase.ErrMergeOtherOrg):
+			c.JSON(http.StatusConflict, apierrors.Response{Error: "Duplicate call belongs to a different organization"})
+		case errors.Is(err, usecase.ErrMergeOtherCaller):
+			c.JSON(http.StatusUnprocessableEntity, apierrors.Response{Error: "Duplicate call has a different number or client"})
+		default:
+			h.l.Error().Err(err).Msg("Failed to merge calls")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to merge calls"})
+		}
+		return
+	}
+
+	h.l.Info().Int64("callID", callID).Interface("duplicateIDs", input.DuplicateIDs).Msg("Calls success merged")
+
+	c.JSON(http.StatusOK, call)
+}
@@ -0,0 +1,142 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMergeCalls(t *testing.T) {
+	tests := []struct {
+		name             string
+		callID           string
+		requestBody      string
+		mockReturn       *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful merge",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockReturn:       &entity.CallResponse{ID: 1, Version: 2},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.CallResponse{ID: 1, Version: 2},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Call not found",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found or does not belong to user"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Duplicate not found",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockErr:          usecase.ErrDuplicateNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Duplicate call not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Duplicate from another organization",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockErr:          usecase.ErrMergeOtherOrg,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "Duplicate call belongs to a different organization"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Duplicate from another caller",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockErr:          usecase.ErrMergeOtherCaller,
+			expectedStatus:   http.StatusUnprocessableEntity,
+			expectedResponse: apierrors.Response{Error: "Duplicate call has a different number or client"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Merge into itself",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			mockErr:          usecase.ErrMergeIntoItself,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Call cannot be merged into itself"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "No duplicates",
+			callID:           "1",
+			requestBody:      `{"duplicate_ids":[]}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid call ID",
+			callID:           "abc",
+			requestBody:      `{"duplicate_ids":[2,3]}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("MergeCalls", mock.Anything, entity.CallMerge{CallID: 1, UserID: 123, DuplicateIDs: []int64{2, 3}}).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.callID}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callID+"/merge", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.MergeCalls(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "MergeCalls")
+			}
+		})
+	}
+}
@@ -7,53 +7,133 @@ import (
 	"strings"
 
 	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func Auth() gin.HandlerFunc {
+// AuthOption configures Auth.
+type AuthOption func(*authOptions)
+
+type authOptions struct {
+	queryToken bool
+}
+
+// QueryToken lets clients that cannot set headers, such as EventSource in
+// browsers, pass the token in the access_token query parameter instead of
+// the Authorization header.
+func QueryToken() AuthOption {
+	return func(o *authOptions) {
+		o.queryToken = true
+	}
+}
+
+func Auth(opts ...AuthOption) gin.HandlerFunc {
+	var o authOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Authorization header is missing"})
-			return
-		}
-
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Invalid authorization header format"})
-			return
+		tokenStr, inQuery := "", false
+		if authHeader == "" && o.queryToken {
+			tokenStr, inQuery = c.GetQuery("access_token")
 		}
 
-		tokenStr := parts[1]
-
-		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
-			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(os.Getenv("JWT_SECRET")), nil
-		})
-		if err != nil {
-			if err == jwt.ErrSignatureInvalid {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Invalid token signature"})
+		if !inQuery {
+			var err error
+			if tokenStr, err = bearerToken(authHeader); err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: err.Error()})
 				return
 			}
-			c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Invalid token"})
-			return
 		}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			userID, ok := claims["id"].(float64)
-			if !ok {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Invalid token claims"})
-				return
-			}
-			c.Set("id", int64(userID))
-		} else {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: "Invalid token"})
+		identity, err := parseToken(tokenStr)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, apierrors.Response{Error: err.Error()})
 			return
 		}
+
+		c.Set("id", identity.UserID)
+		c.Set("role", identity.Role)
+		c.Set("org_id", identity.OrgID)
 		c.Next()
 	}
 }
+
+// Identity is the user a token was issued to. OrgID is the organization
+// active in the session the token belongs to, or zero for none.
+type Identity struct {
+	UserID int64
+	Role   string
+	OrgID  int64
+}
+
+// authError is the reason a request is refused, worded for the client.
+type authError string
+
+func (e authError) Error() string {
+	return string(e)
+}
+
+const (
+	errHeaderMissing authError = "Authorization header is missing"
+	errHeaderFormat  authError = "Invalid authorization header format"
+	errSignature     authError = "Invalid token signature"
+	errToken         authError = "Invalid token"
+	errClaims        authError = "Invalid token claims"
+)
+
+// bearerToken takes the token out of an Authorization header.
+func bearerToken(header string) (string, error) {
+	if header == "" {
+		return "", errHeaderMissing
+	}
+
+	parts := strings.Split(header, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return "", errHeaderFormat
+	}
+
+	return parts[1], nil
+}
+
+// parseToken checks a token issued by auth-service and returns the user it
+// was issued to.
+func parseToken(tokenStr string) (Identity, error) {
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
+		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(os.Getenv("JWT_SECRET")), nil
+	})
+	if err != nil {
+		if err == jwt.ErrSignatureInvalid {
+			return Identity{}, errSignature
+		}
+		return Identity{}, errToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return Identity{}, errToken
+	}
+
+	userID, ok := claims["id"].(float64)
+	if !ok {
+		return Identity{}, errClaims
+	}
+
+	// Tokens issued before roles were introduced carry none.
+	role, _ := claims["role"].(string)
+	if role == "" {
+		role = entity.RoleOperator
+	}
+
+	// Tokens issued outside any organization carry none.
+	orgID, _ := claims["org_id"].(float64)
+
+	return Identity{UserID: int64(userID), Role: role, OrgID: int64(orgID)}, nil
+}
@@ -0,0 +1,424 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateOrg creates an organization owned by the authenticated user.
+//
+// @Summary Create organization
+// @Description Creates an organization with the authenticated user as its owner. The calls of an organization are shared by its members; it becomes active for the owner after POST /auth/switch-org
+// @Tags orgs
+// @Accept json
+// @Produce json
+// @Param input body entity.OrganizationDTO true "Organization"
+// @Success 201 {object} entity.Organization "Created organization"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /orgs [post]
+func (h *CallsHandler) CreateOrg(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	var input entity.OrganizationDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	org, err := h.u.CreateOrg(c.Request.Context(), name, userID)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to create organization")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to create organization"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, org)
+}
+
+// GetOrgs returns the organizations of the authenticated user.
+//
+// @Summary Get organizations
+// @Description Retrieves the organizations the authenticated user is a member of ordered by name, with the role of the user and whether the organization is active
+// @Tags orgs
+// @Produce json
+// @Success 200 {array} entity.Organization "Organizations"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /orgs [get]
+func (h *CallsHandler) GetOrgs(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	orgs, err := h.u.GetOrgs(c.Request.Context(), userID, orgID)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get organizations")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get organizations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, orgs)
+}
+
+// GetOrgMembers returns the members of an organization.
+//
+// @Summary Get organization members
+// @Description Retrieves the members of an organization of the authenticated user in the order they joined
+// @Tags orgs
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Success 200 {array} entity.Member "Members"
+// @Failure 400 {object} apierrors.Response "Invalid organization ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Organization not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /orgs/{id}/members [get]
+func (h *CallsHandler) GetOrgMembers(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid organization ID"})
+		return
+	}
+
+	members, err := h.u.GetOrgMembers(c.Request.Context(), orgID, userID)
+	if err != nil {
+		h.orgError(c, err, "Failed to get members")
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// InviteToOrg invites a user to an organization.
+//
+// @Summary Invite to organization
+// @Description Invites a registered user to an organization owned by the authenticated user. The user joins it with POST /invitations/{id}/accept
+// @Tags orgs
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param input body entity.InvitationDTO true "Invitee"
+// @Success 201 {object} entity.Invitation "Created invitation"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Not the owner of the organization"
+// @Failure 404 {object} apierrors.Response "Organization or invitee not found"
+// @Failure 409 {object} apierrors.Response "User is already a member or invited"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /orgs/{id}/invitations [post]
+func (h *CallsHandler) InviteToOrg(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid organization ID"})
+		return
+	}
+
+	var input entity.InvitationDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	invitation, err := h.u.InviteToOrg(c.Request.Context(), entity.Invitation{OrgID: orgID, UserID: input.UserID, InvitedBy: userID})
+	if err != nil {
+		if errors.Is(err, usecase.ErrInviteeNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Invitee not found"})
+			return
+		}
+		h.orgError(c, err, "Failed to invite user")
+		return
+	}
+
+	h.l.Info().Int64("orgID", orgID).Int64("userID", input.UserID).Msg("User invited to organization")
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// RemoveMember removes a member from an organization.
+//
+// @Summary Remove organization member
+// @Description Removes a member from an organization. The owner may remove any other member, and a member may leave by removing themselves; the owner cannot leave. The member stops seeing the calls of the organization at once, except those they created or are assigned; the calls they created in the organization stay visible to its members
+// @Tags orgs
+// @Param id path int true "Organization ID"
+// @Param userID path int true "Member user ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierrors.Response "Invalid ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Not the owner of the organization"
+// @Failure 404 {object} apierrors.Response "Organization or member not found"
+// @Failure 409 {object} apierrors.Response "Owner cannot leave the organization"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /orgs/{id}/members/{userID} [delete]
+func (h *CallsHandler) RemoveMember(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid organization ID"})
+		return
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("userID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid member ID"})
+		return
+	}
+
+	err = h.u.RemoveMember(c.Request.Context(), entity.MemberRemoval{OrgID: orgID, MemberID: memberID, UserID: userID})
+	if err != nil {
+		h.orgError(c, err, "Failed to remove member")
+		return
+	}
+
+	h.l.Info().Int64("orgID", orgID).Int64("userID", memberID).Msg("Member removed from organization")
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// GetInvitations returns the pending invitations of the authenticated user.
+//
+// @Summary Get invitations
+// @Description Retrieves the invitations to organizations the authenticated user has not accepted or declined yet, oldest first
+// @Tags orgs
+// @Produce json
+// @Success 200 {array} entity.Invitation "Invitations"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /invitations [get]
+func (h *CallsHandler) GetInvitations(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	invitations, err := h.u.GetInvitations(c.Request.Context(), userID)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get invitations")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get invitations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// AcceptInvitation makes the authenticated user a member of an organization.
+//
+// @Summary Accept invitation
+// @Description Accepts an invitation of the authenticated user and returns the organization joined. It becomes active after POST /auth/switch-org
+// @Tags orgs
+// @Produce json
+// @Param id path int true "Invitation ID"
+// @Success 200 {object} entity.Organization "Joined organization"
+// @Failure 400 {object} apierrors.Response "Invalid invitation ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Invitation not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /invitations/{id}/accept [post]
+func (h *CallsHandler) AcceptInvitation(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	invitationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid invitation ID"})
+		return
+	}
+
+	org, err := h.u.AcceptInvitation(c.Request.Context(), invitationID, userID)
+	if err != nil {
+		h.orgError(c, err, "Failed to accept invitation")
+		return
+	}
+
+	h.l.Info().Int64("orgID", org.ID).Int64("userID", userID).Msg("User joined organization")
+
+	c.JSON(http.StatusOK, org)
+}
+
+// DeleteInvitation declines or revokes an invitation.
+//
+// @Summary Delete invitation
+// @Description Declines an invitation of the authenticated user, or revokes one sent by an organization the user owns
+// @Tags orgs
+// @Param id path int true "Invitation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierrors.Response "Invalid invitation ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Invitation not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /invitations/{id} [delete]
+func (h *CallsHandler) DeleteInvitation(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	invitationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid invitation ID"})
+		return
+	}
+
+	if err := h.u.DeleteInvitation(c.Request.Context(), invitationID, userID); err != nil {
+		h.orgError(c, err, "Failed to delete invitation")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// SwitchOrg issues a token of the authenticated user for another organization.
+//
+// @Summary Switch organization
+// @Description Makes an organization the authenticated user is a member of active, or none with org_id 0, and returns a new token carrying it in the org_id claim. Requests made with the new token see the calls of the organization, and the calls they create belong to it; other tokens keep their organization. Later logins start in the organization as well
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body entity.SwitchOrgDTO true "Organization"
+// @Success 200 {object} map[string]string "JWT token"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Organization not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /auth/switch-org [post]
+func (h *CallsHandler) SwitchOrg(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	var input entity.SwitchOrgDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	token, err := h.u.SwitchOrg(c.Request.Context(), userID, input.OrgID)
+	if err != nil {
+		h.orgError(c, err, "Failed to switch organization")
+		return
+	}
+
+	h.l.Info().Int64("userID", userID).Int64("orgID", input.OrgID).Msg("User switched organization")
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
+
+func (h *CallsHandler) orgError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrOrgNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Organization not found"})
+	case errors.Is(err, usecase.ErrNotOrgOwner):
+		c.JSON(http.StatusForbidden, apierrors.Response{Error: "Only the owner of the organization may do this"})
+	case errors.Is(err, usecase.ErrAlreadyMember):
+		c.JSON(http.StatusConflict, apierrors.Response{Error: "User is already a member or invited"})
+	case errors.Is(err, usecase.ErrInvitationNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Invitation not found"})
+	case errors.Is(err, usecase.ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Member not found"})
+	case errors.Is(err, usecase.ErrOwnerCannotLeave):
+		c.JSON(http.StatusConflict, apierrors.Response{Error: "Owner cannot leave the organization"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
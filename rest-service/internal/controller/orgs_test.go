@@ -0,0 +1,350 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateOrg(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		expectedName     string
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful creation",
+			requestBody:    `{"name":" Sales "}`,
+			expectedName:   "Sales",
+			expectedStatus: http.StatusCreated,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Blank name",
+			requestBody:      `{"name":"   "}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Missing name",
+			requestBody:      `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("CreateOrg", mock.Anything, tt.expectedName, int64(123)).
+					Return(&entity.Organization{ID: 1, Name: tt.expectedName, Role: entity.OrgOwner}, nil)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/orgs", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.CreateOrg(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusCreated {
+				var response entity.Organization
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, entity.OrgOwner, response.Role)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "CreateOrg")
+			}
+		})
+	}
+}
+
+func TestInviteToOrg(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful invitation",
+			requestBody:    `{"user_id":7}`,
+			expectedStatus: http.StatusCreated,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Not the owner",
+			requestBody:      `{"user_id":7}`,
+			mockErr:          usecase.ErrNotOrgOwner,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Only the owner of the organization may do this"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invitee not found",
+			requestBody:      `{"user_id":7}`,
+			mockErr:          usecase.ErrInviteeNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Invitee not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Already a member",
+			requestBody:      `{"user_id":7}`,
+			mockErr:          usecase.ErrAlreadyMember,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "User is already a member or invited"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Missing user",
+			requestBody:      `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				var invitation *entity.Invitation
+				if tt.mockErr == nil {
+					invitation = &entity.Invitation{ID: 3, OrgID: 1, OrgName: "Sales", UserID: 7, InvitedBy: 123}
+				}
+				mockUseCase.On("InviteToOrg", mock.Anything, entity.Invitation{OrgID: 1, UserID: 7, InvitedBy: 123}).
+					Return(invitation, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("POST", "/orgs/1/invitations", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.InviteToOrg(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusCreated {
+				var response entity.Invitation
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), response.ID)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "InviteToOrg")
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	tests := []struct {
+		name           string
+		memberID       string
+		mockErr        error
+		expectedStatus int
+		shouldCallMock bool
+	}{
+		{name: "Owner removes member", memberID: "7", expectedStatus: http.StatusNoContent, shouldCallMock: true},
+		{name: "Member removes another", memberID: "7", mockErr: usecase.ErrNotOrgOwner, expectedStatus: http.StatusForbidden, shouldCallMock: true},
+		{name: "Owner leaves", memberID: "7", mockErr: usecase.ErrOwnerCannotLeave, expectedStatus: http.StatusConflict, shouldCallMock: true},
+		{name: "Member not found", memberID: "7", mockErr: usecase.ErrMemberNotFound, expectedStatus: http.StatusNotFound, shouldCallMock: true},
+		{name: "Invalid member ID", memberID: "abc", expectedStatus: http.StatusBadRequest, shouldCallMock: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("RemoveMember", mock.Anything, entity.MemberRemoval{OrgID: 1, MemberID: 7, UserID: 123}).Return(tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "userID", Value: tt.memberID}}
+			c.Request = httptest.NewRequest("DELETE", "/orgs/1/members/"+tt.memberID, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.RemoveMember(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "RemoveMember")
+			}
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "Successful acceptance", expectedStatus: http.StatusOK},
+		{name: "Invitation not found", mockErr: usecase.ErrInvitationNotFound, expectedStatus: http.StatusNotFound},
+		{name: "Repository error", mockErr: errors.New("connection refused"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			var org *entity.Organization
+			if tt.mockErr == nil {
+				org = &entity.Organization{ID: 1, Name: "Sales", Role: entity.OrgMember}
+			}
+			mockUseCase.On("AcceptInvitation", mock.Anything, int64(3), int64(123)).Return(org, tt.mockErr)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "3"}}
+			c.Request = httptest.NewRequest("POST", "/invitations/3/accept", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.AcceptInvitation(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.Organization
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, entity.OrgMember, response.Role)
+			}
+		})
+	}
+}
+
+func TestSwitchOrg(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		expectedOrgID    int64
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Switch to organization",
+			requestBody:    `{"org_id":1}`,
+			expectedOrgID:  1,
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Switch to none",
+			requestBody:    `{"org_id":0}`,
+			expectedStatus: http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Not a member",
+			requestBody:      `{"org_id":2}`,
+			expectedOrgID:    2,
+			mockErr:          usecase.ErrOrgNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Organization not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Negative organization",
+			requestBody:      `{"org_id":-1}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				token := ""
+				if tt.mockErr == nil {
+					token = "token"
+				}
+				mockUseCase.On("SwitchOrg", mock.Anything, int64(123), tt.expectedOrgID).Return(token, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/auth/switch-org", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.SwitchOrg(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response map[string]string
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "token", response["token"])
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "SwitchOrg")
+			}
+		})
+	}
+}
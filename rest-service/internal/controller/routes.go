@@ -1,38 +1,197 @@
 package controller
 
 import (
+	"time"
+
+	"calls-service/pkg/httpserver"
+	"calls-service/pkg/phone"
 	"calls-service/rest-service/internal/controller/middleware"
 	"calls-service/rest-service/internal/usecase"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
 	"github.com/rs/zerolog"
 )
 
 type CallsHandler struct {
-	u usecase.UseCase
-	l zerolog.Logger
+	u            usecase.UseCase
+	l            zerolog.Logger
+	phones       *phone.Parser
+	attachments  attachmentLimits
+	callbackZone *time.Location
+	origins      []string
+	graphql      *graphql.Schema
+	upgrader     websocket.Upgrader
+	gqlUpgrader  websocket.Upgrader
+}
+
+// Option configures a CallsHandler.
+type Option func(*CallsHandler)
+
+// PhoneParser sets the parser of phone numbers, which reads national numbers
+// in phone.DefaultRegion unless set.
+func PhoneParser(p *phone.Parser) Option {
+	return func(h *CallsHandler) {
+		h.phones = p
+	}
+}
+
+// AttachmentLimits sets the maximum size of an attached file in bytes and
+// the MIME types it may have, defaultAttachmentTypes unless set.
+func AttachmentLimits(maxSize int64, types []string) Option {
+	return func(h *CallsHandler) {
+		h.attachments = attachmentLimits{maxSize: maxSize, types: types}
+	}
+}
+
+// CallbackTimezone sets the time zone of callbacks scheduled without one,
+// UTC unless set.
+func CallbackTimezone(loc *time.Location) Option {
+	return func(h *CallsHandler) {
+		h.callbackZone = loc
+	}
 }
 
-func New(u usecase.UseCase, l zerolog.Logger) *CallsHandler {
-	return &CallsHandler{u: u, l: l}
+// AllowedOrigins sets the origins of the pages, besides those of the host of
+// the service itself, that may open WebSockets; "*" allows every origin.
+func AllowedOrigins(origins []string) Option {
+	return func(h *CallsHandler) {
+		h.origins = origins
+	}
+}
+
+func New(u usecase.UseCase, l zerolog.Logger, opts ...Option) *CallsHandler {
+	phones, _ := phone.NewParser(phone.DefaultRegion)
+	h := &CallsHandler{
+		u:            u,
+		l:            l,
+		phones:       phones,
+		attachments:  attachmentLimits{maxSize: defaultMaxAttachmentSize, types: defaultAttachmentTypes},
+		callbackZone: time.UTC,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.graphql = newGQLSchema(h)
+	h.upgrader = websocket.Upgrader{CheckOrigin: h.checkOrigin}
+	h.gqlUpgrader = websocket.Upgrader{Subprotocols: []string{gqlSubprotocol}, CheckOrigin: h.checkOrigin}
+	return h
 }
 
-func NewCallsRoutes(router *gin.Engine, h *CallsHandler) {
+// NewCallsRoutes registers the API routes. downloadTimeout replaces the server
+// write timeout for exports and attachments, which may take long to download,
+// and uploadTimeout the read and write timeouts for imports and attachment
+// uploads, which may take long to upload and process.
+func NewCallsRoutes(router *gin.Engine, h *CallsHandler, downloadTimeout, uploadTimeout time.Duration) {
 
 	authGroup := router.Group("/auth")
 	{
 		authGroup.POST("/register", h.register)
 		authGroup.POST("/login", h.login)
+		authGroup.POST("/switch-org", middleware.Auth(), h.SwitchOrg)
 	}
 
+	// Streams stay open for good, and browsers cannot set headers on them.
+	streamGroup := router.Group("/calls", middleware.Auth(middleware.QueryToken()), httpserver.WriteTimeout(0))
+	{
+		streamGroup.GET("/stream", h.StreamCalls)
+		streamGroup.GET("/ws", h.CallsWebSocket)
+	}
+
+	router.POST("/graphql", middleware.Auth(), h.GraphQL)
+	router.GET("/graphql", middleware.Auth(middleware.QueryToken()), httpserver.WriteTimeout(0), h.GraphQLWebSocket)
+
 	callsGroup := router.Group("/calls")
 
 	callsGroup.Use(middleware.Auth())
 	{
 		callsGroup.POST("", h.SaveCall)
 		callsGroup.GET("", h.GetUserCalls)
+		callsGroup.GET("/search", h.SearchCalls)
+		callsGroup.GET("/stats", h.GetCallStats)
+		callsGroup.GET("/export", httpserver.WriteTimeout(downloadTimeout), h.ExportCalls)
+		callsGroup.GET("/trash", h.GetTrash)
+		callsGroup.GET("/callbacks/upcoming", h.GetUpcomingCallbacks)
+		callsGroup.POST("/bulk", h.BulkCreateCalls)
+		callsGroup.POST("/bulk/status", h.BulkUpdateCallStatus)
+		callsGroup.POST("/bulk/delete", h.BulkDeleteCalls)
+		callsGroup.POST("/import", httpserver.ReadTimeout(uploadTimeout), httpserver.WriteTimeout(uploadTimeout), h.ImportCalls)
 		callsGroup.GET("/:id", h.GetUserCallByID)
+		callsGroup.GET("/:id/history", h.GetCallHistory)
+		callsGroup.PATCH("/:id", h.UpdateCall)
 		callsGroup.PATCH("/:id/status", h.UpdateCallStatus)
+		callsGroup.POST("/:id/assign", h.AssignCall)
+		callsGroup.POST("/:id/unassign", h.UnassignCall)
 		callsGroup.DELETE("/:id", h.DeleteCall)
+		callsGroup.POST("/:id/restore", h.RestoreCall)
+		callsGroup.POST("/:id/merge", h.MergeCalls)
+
+		callsGroup.PUT("/:id/callback", h.ScheduleCallback)
+		callsGroup.DELETE("/:id/callback", h.CancelCallback)
+		callsGroup.POST("/:id/callback/snooze", h.SnoozeCallback)
+
+		callsGroup.POST("/:id/comments", h.AddComment)
+		callsGroup.GET("/:id/comments", h.GetComments)
+		callsGroup.PATCH("/:id/comments/:commentID", h.UpdateComment)
+		callsGroup.DELETE("/:id/comments/:commentID", h.DeleteComment)
+
+		callsGroup.POST("/:id/tags", h.AttachTag)
+		callsGroup.DELETE("/:id/tags/:tagID", h.DetachTag)
+
+		callsGroup.POST("/:id/attachments", httpserver.ReadTimeout(uploadTimeout), httpserver.WriteTimeout(uploadTimeout), h.AddAttachment)
+		callsGroup.GET("/:id/attachments", h.GetAttachments)
+		callsGroup.GET("/:id/attachments/:attachmentID", httpserver.WriteTimeout(downloadTimeout), h.DownloadAttachment)
+		callsGroup.DELETE("/:id/attachments/:attachmentID", h.DeleteAttachment)
+	}
+
+	tagsGroup := router.Group("/tags")
+
+	tagsGroup.Use(middleware.Auth())
+	{
+		tagsGroup.POST("", h.CreateTag)
+		tagsGroup.GET("", h.GetTags)
+		tagsGroup.PATCH("/:id", h.RenameTag)
+		tagsGroup.DELETE("/:id", h.DeleteTag)
+	}
+
+	webhooksGroup := router.Group("/webhooks")
+
+	webhooksGroup.Use(middleware.Auth())
+	{
+		webhooksGroup.POST("", h.CreateWebhook)
+		webhooksGroup.GET("", h.GetWebhooks)
+		webhooksGroup.DELETE("/:id", h.DeleteWebhook)
+		webhooksGroup.GET("/:id/deliveries", h.GetWebhookDeliveries)
+		webhooksGroup.POST("/:id/deliveries/:deliveryID/redeliver", h.RedeliverWebhook)
+	}
+
+	clientsGroup := router.Group("/clients")
+
+	clientsGroup.Use(middleware.Auth())
+	{
+		clientsGroup.GET("", h.GetClients)
+		clientsGroup.GET("/:id", h.GetClient)
+		clientsGroup.GET("/:id/calls", h.GetClientCalls)
+	}
+
+	orgsGroup := router.Group("/orgs")
+
+	orgsGroup.Use(middleware.Auth())
+	{
+		orgsGroup.POST("", h.CreateOrg)
+		orgsGroup.GET("", h.GetOrgs)
+		orgsGroup.GET("/:id/members", h.GetOrgMembers)
+		orgsGroup.DELETE("/:id/members/:userID", h.RemoveMember)
+		orgsGroup.POST("/:id/invitations", h.InviteToOrg)
+	}
+
+	invitationsGroup := router.Group("/invitations")
+
+	invitationsGroup.Use(middleware.Auth())
+	{
+		invitationsGroup.GET("", h.GetInvitations)
+		invitationsGroup.POST("/:id/accept", h.AcceptInvitation)
+		invitationsGroup.DELETE("/:id", h.DeleteInvitation)
 	}
 }
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetCallStats returns call statistics for dashboards.
+//
+// @Summary Get call statistics
+// @Description Returns the number of open calls and of calls in each status, the numbers of calls created and closed on each day from from to to (UTC, 30 days up to today by default, at most 366 days) and the average and percentile time to close the calls closed in that period. Operators get the statistics of the calls visible to them, supervisors of the whole active organization, which they must have
+// @Tags calls
+// @Produce json
+// @Param from query string false "First day, YYYY-MM-DD"
+// @Param to query string false "Last day, YYYY-MM-DD"
+// @Success 200 {object} entity.CallStats "Statistics"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Team statistics without an active organization"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/stats [get]
+func (h *CallsHandler) GetCallStats(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	var input entity.StatsDTO
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	stats, err := h.u.GetCallStats(c.Request.Context(), entity.StatsQuery{
+		UserID: userID,
+		OrgID:  orgID,
+		Team:   c.GetString("role") == entity.RoleSupervisor,
+		From:   input.From,
+		To:     input.To,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidStatsRange):
+			c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid date range"})
+			return
+		case errors.Is(err, usecase.ErrNoTeamOrg):
+			c.JSON(http.StatusForbidden, apierrors.Response{Error: "Team statistics require an active organization"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to get call stats")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get call stats"})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,146 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCallStats(t *testing.T) {
+	avg := 3600.0
+	stats := entity.CallStats{
+		Scope:      entity.StatsScopeOwn,
+		From:       "2024-03-01",
+		To:         "2024-03-01",
+		Open:       1,
+		ByStatus:   []entity.StatusCount{{Status: "new", Label: "Новая", Count: 1}, {Status: "closed", Label: "Закрыта", Count: 2}},
+		Daily:      []entity.DailyCount{{Date: "2024-03-01", Created: 3, Closed: 2}},
+		Resolution: entity.ResolutionStats{Closed: 2, AvgSeconds: &avg, P50Seconds: &avg, P90Seconds: &avg, P95Seconds: &avg},
+	}
+	march1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		query            string
+		role             string
+		orgID            int64
+		expectedQuery    entity.StatsQuery
+		mockReturn       *entity.CallStats
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Own statistics",
+			query:            "?from=2024-03-01&to=2024-03-01",
+			role:             entity.RoleOperator,
+			expectedQuery:    entity.StatsQuery{UserID: 123, From: march1, To: march1},
+			mockReturn:       &stats,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: stats,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Team statistics for supervisors",
+			query:            "",
+			role:             entity.RoleSupervisor,
+			orgID:            5,
+			expectedQuery:    entity.StatsQuery{UserID: 123, OrgID: 5, Team: true},
+			mockReturn:       &stats,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: stats,
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Team statistics without an organization",
+			query:            "",
+			role:             entity.RoleSupervisor,
+			expectedQuery:    entity.StatsQuery{UserID: 123, Team: true},
+			mockErr:          usecase.ErrNoTeamOrg,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Team statistics require an active organization"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid date range",
+			query:            "?from=2024-03-02&to=2024-03-01",
+			role:             entity.RoleOperator,
+			expectedQuery:    entity.StatsQuery{UserID: 123, From: march1.AddDate(0, 0, 1), To: march1},
+			mockErr:          usecase.ErrInvalidStatsRange,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid date range"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid date",
+			query:            "?from=01.03.2024",
+			role:             entity.RoleOperator,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			query:            "",
+			role:             entity.RoleOperator,
+			expectedQuery:    entity.StatsQuery{UserID: 123},
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to get call stats"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetCallStats", mock.Anything, tt.expectedQuery).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Set("role", tt.role)
+			c.Set("org_id", tt.orgID)
+			c.Request = httptest.NewRequest("GET", "/calls/stats"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetCallStats(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallStats
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetCallStats")
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// streamHeartbeat keeps idle streams from being closed by proxies.
+	streamHeartbeat = 15 * time.Second
+	wsWriteWait     = 10 * time.Second
+)
+
+// checkOrigin lets a WebSocket be opened by a page of the host of the service
+// or of an allowed origin. Requests without Origin come from clients other
+// than browsers, which are not subject to it.
+func (h *CallsHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	for _, allowed := range h.origins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamCalls pushes changes of the calls of the authenticated user as
+// Server-Sent Events.
+//
+// @Summary Stream call changes
+// @Description Pushes an event whenever a call visible to the authenticated user is created, changed or deleted, by any instance of the service. The event name is the type of the change: created for a call that came into view, updated, or deleted for a call that left it; the data is the change with the call after it, and the event ID is the ID of the change. A comment is sent every 15 seconds to keep the connection open. Changes made while the client is disconnected are not replayed, so it should reload the calls after reconnecting. Browsers may pass the token in the access_token query parameter, since EventSource cannot set headers
+// @Tags calls
+// @Produce text/event-stream
+// @Param access_token query string false "JWT, instead of the Authorization header"
+// @Success 200 {object} entity.CallStreamEvent "Stream of call changes"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/stream [get]
+func (h *CallsHandler) StreamCalls(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	ctx := c.Request.Context()
+	events := h.u.SubscribeCalls(ctx, userID, orgID)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			c.Render(-1, sse.Event{Id: strconv.FormatInt(e.ID, 10), Event: e.Type, Data: e})
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// CallsWebSocket pushes changes of the calls of the authenticated user over a
+// WebSocket.
+//
+// @Summary Call changes over WebSocket
+// @Description Upgrades the connection to a WebSocket and sends a JSON text message whenever a call visible to the authenticated user is created, changed or deleted, with the same content as the events of GET /calls/stream. The server pings the client every 15 seconds and closes the socket if it does not answer; messages from the client are ignored. Browsers may pass the token in the access_token query parameter; pages of other hosts may only open the socket from an allowed origin
+// @Tags calls
+// @Param access_token query string false "JWT, instead of the Authorization header"
+// @Success 101 {object} entity.CallStreamEvent "Messages with call changes"
+// @Failure 400 {object} apierrors.Response "Not a WebSocket handshake"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Origin not allowed"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/ws [get]
+func (h *CallsHandler) CallsWebSocket(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	// Upgrade replies with an error itself.
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	events := h.u.SubscribeCalls(ctx, userID, orgID)
+
+	// Reading processes pongs and close frames and notices when the client
+	// goes away, which ends the stream.
+	conn.SetReadLimit(512)
+	_ = conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(wsWriteWait))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/middleware"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func streamEvents(events ...entity.CallStreamEvent) <-chan entity.CallStreamEvent {
+	ch := make(chan entity.CallStreamEvent, len(events))
+	for _, e := range events {
+		ch <- e
+	}
+	close(ch)
+	return ch
+}
+
+func TestStreamCalls(t *testing.T) {
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("SubscribeCalls", mock.Anything, int64(123), int64(0)).Return(streamEvents(
+		entity.CallStreamEvent{ID: 7, Type: entity.StreamCallCreated, Event: entity.EventCreated, CallID: 1, Call: &entity.CallResponse{ID: 1}},
+		entity.CallStreamEvent{ID: 8, Type: entity.StreamCallDeleted, Event: entity.EventDeleted, CallID: 1},
+	))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("id", int64(123))
+	c.Request = httptest.NewRequest("GET", "/calls/stream", nil)
+
+	handler := controller.New(mockUseCase, zerolog.Nop())
+
+	handler.StreamCalls(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	assert.Contains(t, body, "id:7\nevent:created\ndata:{\"id\":7,\"type\":\"created\",\"event\":\"created\",\"call_id\":1,\"call\":{")
+	assert.Contains(t, body, "id:8\nevent:deleted\ndata:{\"id\":8,\"type\":\"deleted\",\"event\":\"deleted\",\"call_id\":1}\n\n")
+}
+
+func TestCallsWebSocket(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	events := make(chan entity.CallStreamEvent, 1)
+	events <- entity.CallStreamEvent{ID: 7, Type: entity.StreamCallUpdated, Event: entity.EventStatusChanged, CallID: 1}
+
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.On("SubscribeCalls", mock.Anything, int64(123), int64(0)).Return((<-chan entity.CallStreamEvent)(events))
+
+	router := gin.New()
+	router.GET("/calls/ws", middleware.Auth(middleware.QueryToken()), controller.New(mockUseCase, zerolog.Nop()).CallsWebSocket)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": 123}).SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/calls/ws"
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Error(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?access_token="+token, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	var e entity.CallStreamEvent
+	_, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, &e))
+	assert.Equal(t, entity.CallStreamEvent{ID: 7, Type: entity.StreamCallUpdated, Event: entity.EventStatusChanged, CallID: 1}, e)
+
+	close(events)
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway))
+}
+
+func TestCallsWebSocketOrigin(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	tests := []struct {
+		name           string
+		origin         string
+		expectedStatus int
+	}{
+		{name: "No origin", expectedStatus: http.StatusSwitchingProtocols},
+		{name: "Same host", origin: "http://{host}", expectedStatus: http.StatusSwitchingProtocols},
+		{name: "Allowed origin", origin: "https://crm.example.com", expectedStatus: http.StatusSwitchingProtocols},
+		{name: "Other origin", origin: "https://evil.example.com", expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.On("SubscribeCalls", mock.Anything, int64(123), int64(0)).Return(streamEvents()).Maybe()
+
+			handler := controller.New(mockUseCase, zerolog.Nop(), controller.AllowedOrigins([]string{"https://crm.example.com/"}))
+			router := gin.New()
+			router.GET("/calls/ws", middleware.Auth(middleware.QueryToken()), handler.CallsWebSocket)
+			server := httptest.NewServer(router)
+			defer server.Close()
+
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": 123}).SignedString([]byte("test-secret"))
+			assert.NoError(t, err)
+
+			host := strings.TrimPrefix(server.URL, "http://")
+			header := http.Header{}
+			if tt.origin != "" {
+				header.Set("Origin", strings.ReplaceAll(tt.origin, "{host}", host))
+			}
+
+			conn, resp, _ := websocket.DefaultDialer.Dial("ws://"+host+"/calls/ws?access_token="+token, header)
+			if conn != nil {
+				_ = conn.Close()
+			}
+			if assert.NotNil(t, resp) {
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
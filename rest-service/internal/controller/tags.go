@@ -0,0 +1,326 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateTag adds a tag to the catalogue of the authenticated user.
+//
+// @Summary Create tag
+// @Description Adds a tag to the catalogue of the authenticated user. Tag names are unique regardless of case
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param input body entity.TagDTO true "Tag"
+// @Success 201 {object} entity.Tag "Created tag"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 409 {object} apierrors.Response "Tag already exists"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /tags [post]
+func (h *CallsHandler) CreateTag(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	name, ok := bindTagName(c)
+	if !ok {
+		return
+	}
+
+	tag, err := h.u.CreateTag(c.Request.Context(), entity.Tag{UserID: userID, Name: name})
+	if err != nil {
+		if errors.Is(err, usecase.ErrTagExists) {
+			c.JSON(http.StatusConflict, apierrors.Response{Error: "Tag already exists"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to create tag")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to create tag"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tag)
+}
+
+// GetTags returns the tag catalogue of the authenticated user.
+//
+// @Summary Get tags
+// @Description Retrieves the tag catalogue of the authenticated user ordered by name
+// @Tags tags
+// @Produce json
+// @Success 200 {array} entity.Tag "Tags"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /tags [get]
+func (h *CallsHandler) GetTags(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	tags, err := h.u.GetTags(c.Request.Context(), userID)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get tags")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// RenameTag changes the name of a tag.
+//
+// @Summary Rename tag
+// @Description Renames a tag of the authenticated user. The new name is shown on every call the tag is attached to
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param input body entity.TagDTO true "Tag"
+// @Success 200 {object} entity.Tag "Renamed tag"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Tag not found"
+// @Failure 409 {object} apierrors.Response "Tag already exists"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /tags/{id} [patch]
+func (h *CallsHandler) RenameTag(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	tagID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid tag ID"})
+		return
+	}
+
+	name, ok := bindTagName(c)
+	if !ok {
+		return
+	}
+
+	tag, err := h.u.RenameTag(c.Request.Context(), entity.Tag{ID: tagID, UserID: userID, Name: name})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTagNotFound):
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Tag not found"})
+		case errors.Is(err, usecase.ErrTagExists):
+			c.JSON(http.StatusConflict, apierrors.Response{Error: "Tag already exists"})
+		default:
+			h.l.Error().Err(err).Msg("Failed to rename tag")
+			c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to rename tag"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// DeleteTag removes a tag from the catalogue and from every call.
+//
+// @Summary Delete tag
+// @Description Deletes a tag of the authenticated user and detaches it from every call
+// @Tags tags
+// @Param id path int true "Tag ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} apierrors.Response "Invalid tag ID"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Tag not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /tags/{id} [delete]
+func (h *CallsHandler) DeleteTag(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	tagID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid tag ID"})
+		return
+	}
+
+	if err := h.u.DeleteTag(c.Request.Context(), tagID, userID); err != nil {
+		if errors.Is(err, usecase.ErrTagNotFound) {
+			c.JSON(http.StatusNotFound, apierrors.Response{Error: "Tag not found"})
+			return
+		}
+		h.l.Error().Err(err).Msg("Failed to delete tag")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to delete tag"})
+		return
+	}
+
+	h.l.Info().Int64("tagID", tagID).Msg("Tag success deleted")
+
+	c.Status(http.StatusNoContent)
+}
+
+// AttachTag attaches a tag to a call.
+//
+// @Summary Attach tag to call
+// @Description Attaches a tag from the catalogue of the authenticated user to a call. Attaching an already attached tag changes nothing
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param input body entity.AttachTagDTO true "Tag"
+// @Success 200 {object} entity.CallResponse "Call with its tags"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call or tag not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/tags [post]
+func (h *CallsHandler) AttachTag(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	var input entity.AttachTagDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	call, err := h.u.AttachTag(c.Request.Context(), entity.TagChange{CallID: callID, TagID: input.TagID, UserID: userID, OrgID: orgID})
+	if err != nil {
+		h.tagChangeError(c, err, "Failed to attach tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, call)
+}
+
+// DetachTag detaches a tag from a call.
+//
+// @Summary Detach tag from call
+// @Description Detaches a tag from a call
+// @Tags tags
+// @Produce json
+// @Param id path int true "Call ID"
+// @Param tagID path int true "Tag ID"
+// @Success 200 {object} entity.CallResponse "Call with its tags"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Call not found or tag not attached"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /calls/{id}/tags/{tagID} [delete]
+func (h *CallsHandler) DetachTag(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+	orgID := c.GetInt64("org_id")
+
+	callID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid call ID"})
+		return
+	}
+
+	tagID, err := strconv.ParseInt(c.Param("tagID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid tag ID"})
+		return
+	}
+
+	call, err := h.u.DetachTag(c.Request.Context(), entity.TagChange{CallID: callID, TagID: tagID, UserID: userID, OrgID: orgID})
+	if err != nil {
+		h.tagChangeError(c, err, "Failed to detach tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, call)
+}
+
+// bindTagName reads a tag name from the request body and answers 400 if it is
+// blank after trimming.
+func bindTagName(c *gin.Context) (string, bool) {
+	var input entity.TagDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return "", false
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return "", false
+	}
+	return name, true
+}
+
+func (h *CallsHandler) tagChangeError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrCallNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Call not found"})
+	case errors.Is(err, usecase.ErrTagNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Tag not found"})
+	case errors.Is(err, usecase.ErrTagNotAttached):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Tag is not attached to the call"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
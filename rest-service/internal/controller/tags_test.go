@@ -0,0 +1,401 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateTag(t *testing.T) {
+	tests := []struct {
+		name             string
+		requestBody      string
+		expectedTag      entity.Tag
+		mockReturn       *entity.Tag
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful creation",
+			requestBody:      `{"name":"  Billing "}`,
+			expectedTag:      entity.Tag{UserID: 123, Name: "Billing"},
+			mockReturn:       &entity.Tag{ID: 1, UserID: 123, Name: "Billing"},
+			expectedStatus:   http.StatusCreated,
+			expectedResponse: entity.Tag{ID: 1, UserID: 123, Name: "Billing"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Duplicate name",
+			requestBody:      `{"name":"billing"}`,
+			expectedTag:      entity.Tag{UserID: 123, Name: "billing"},
+			mockErr:          usecase.ErrTagExists,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "Tag already exists"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Blank name",
+			requestBody:      `{"name":"   "}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			requestBody:      `{"name":"Billing"}`,
+			expectedTag:      entity.Tag{UserID: 123, Name: "Billing"},
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to create tag"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("CreateTag", mock.Anything, tt.expectedTag).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Request = httptest.NewRequest("POST", "/tags", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.CreateTag(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusCreated {
+				var response entity.Tag
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "CreateTag")
+			}
+		})
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		name             string
+		tagID            string
+		requestBody      string
+		mockReturn       *entity.Tag
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful rename",
+			tagID:            "1",
+			requestBody:      `{"name":"Complaint"}`,
+			mockReturn:       &entity.Tag{ID: 1, UserID: 123, Name: "Complaint"},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.Tag{ID: 1, UserID: 123, Name: "Complaint"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Tag not found",
+			tagID:            "1",
+			requestBody:      `{"name":"Complaint"}`,
+			mockErr:          usecase.ErrTagNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Tag not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Name taken",
+			tagID:            "1",
+			requestBody:      `{"name":"Complaint"}`,
+			mockErr:          usecase.ErrTagExists,
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: apierrors.Response{Error: "Tag already exists"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid tag ID",
+			tagID:            "abc",
+			requestBody:      `{"name":"Complaint"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid tag ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("RenameTag", mock.Anything, entity.Tag{ID: 1, UserID: 123, Name: "Complaint"}).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.tagID}}
+			c.Request = httptest.NewRequest("PATCH", "/tags/"+tt.tagID, bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.RenameTag(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.Tag
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "RenameTag")
+			}
+		})
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{"Successful deletion", nil, http.StatusNoContent},
+		{"Tag not found", usecase.ErrTagNotFound, http.StatusNotFound},
+		{"Internal server error", errors.New("db error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.On("DeleteTag", mock.Anything, int64(1), int64(123)).Return(tt.mockErr)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("DELETE", "/tags/1", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DeleteTag(c)
+			c.Writer.WriteHeaderNow()
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestAttachTag(t *testing.T) {
+	tests := []struct {
+		name             string
+		callID           string
+		requestBody      string
+		mockReturn       *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful attach",
+			callID:           "1",
+			requestBody:      `{"tag_id":5}`,
+			mockReturn:       &entity.CallResponse{ID: 1, Tags: []string{"Billing"}},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.CallResponse{ID: 1, Tags: []string{"Billing"}},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Call not found",
+			callID:           "1",
+			requestBody:      `{"tag_id":5}`,
+			mockErr:          usecase.ErrCallNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Call not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Tag not found",
+			callID:           "1",
+			requestBody:      `{"tag_id":5}`,
+			mockErr:          usecase.ErrTagNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Tag not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Missing tag ID",
+			callID:           "1",
+			requestBody:      `{}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Invalid call ID",
+			callID:           "abc",
+			requestBody:      `{"tag_id":5}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid call ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("AttachTag", mock.Anything, entity.TagChange{CallID: 1, TagID: 5, UserID: 123}).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: tt.callID}}
+			c.Request = httptest.NewRequest("POST", "/calls/"+tt.callID+"/tags", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.AttachTag(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "AttachTag")
+			}
+		})
+	}
+}
+
+func TestDetachTag(t *testing.T) {
+	tests := []struct {
+		name             string
+		tagID            string
+		mockReturn       *entity.CallResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse any
+		shouldCallMock   bool
+	}{
+		{
+			name:             "Successful detach",
+			tagID:            "5",
+			mockReturn:       &entity.CallResponse{ID: 1, Tags: []string{}},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: entity.CallResponse{ID: 1, Tags: []string{}},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Tag not attached",
+			tagID:            "5",
+			mockErr:          usecase.ErrTagNotAttached,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Tag is not attached to the call"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid tag ID",
+			tagID:            "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid tag ID"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Internal server error",
+			tagID:            "5",
+			mockErr:          errors.New("db error"),
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: apierrors.Response{Error: "Failed to detach tag"},
+			shouldCallMock:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("DetachTag", mock.Anything, entity.TagChange{CallID: 1, TagID: 5, UserID: 123}).Return(tt.mockReturn, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "tagID", Value: tt.tagID}}
+			c.Request = httptest.NewRequest("DELETE", "/calls/1/tags/"+tt.tagID, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DetachTag(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response entity.CallResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "DetachTag")
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateWebhook subscribes a URL to call lifecycle events.
+//
+// @Summary Create webhook
+// @Description Subscribes a URL to call events: call.created, call.updated, call.status_changed, call.deleted, call.restored and call.sla_changed, all of them if events is empty. The webhook receives the events of the calls the authenticated user created or is assigned and of the organizations they are a member of. With team set it also receives those of every call of the active organization while the user stays a member of it; only supervisors with an active organization may do this. The URL must resolve to public addresses only. Every delivery is signed with the returned secret, which is not shown again
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param input body entity.WebhookDTO true "Webhook"
+// @Success 201 {object} entity.Webhook "Created webhook with its secret"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 403 {object} apierrors.Response "Team webhooks are only for supervisors of an active organization"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /webhooks [post]
+func (h *CallsHandler) CreateWebhook(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	var input entity.WebhookDTO
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid request format"})
+		return
+	}
+
+	if input.Team && c.GetString("role") != entity.RoleSupervisor {
+		c.JSON(http.StatusForbidden, apierrors.Response{Error: "Team webhooks are only for supervisors"})
+		return
+	}
+
+	// A team webhook covers the organization of the session it is created in.
+	var orgID int64
+	if input.Team {
+		orgID = c.GetInt64("org_id")
+		if orgID == 0 {
+			c.JSON(http.StatusForbidden, apierrors.Response{Error: "Team webhooks require an active organization"})
+			return
+		}
+	}
+
+	webhook, err := h.u.CreateWebhook(c.Request.Context(), entity.Webhook{
+		UserID: userID,
+		URL:    input.URL,
+		Events: input.Events,
+		Team:   input.Team,
+		OrgID:  orgID,
+	})
+	if err != nil {
+		h.webhookError(c, err, "Failed to create webhook")
+		return
+	}
+
+	h.l.Info().Int64("webhookID", webhook.ID).Msg("Webhook success created")
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// GetWebhooks returns the webhooks of the authenticated user.
+//
+// @Summary Get webhooks
+// @Description Retrieves the webhooks of the authenticated user without their secrets
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} entity.Webhook "Webhooks"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /webhooks [get]
+func (h *CallsHandler) GetWebhooks(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	webhooks, err := h.u.GetWebhooks(c.Request.Context(), userID)
+	if err != nil {
+		h.l.Error().Err(err).Msg("Failed to get webhooks")
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Failed to get webhooks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// DeleteWebhook removes a webhook of the authenticated user.
+//
+// @Summary Delete webhook
+// @Description Deletes a webhook together with its delivery log; pending deliveries are not sent
+// @Tags webhooks
+// @Param id path int true "Webhook ID"
+// @Success 204 "Deleted"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Webhook not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /webhooks/{id} [delete]
+func (h *CallsHandler) DeleteWebhook(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid webhook ID"})
+		return
+	}
+
+	if err := h.u.DeleteWebhook(c.Request.Context(), webhookID, userID); err != nil {
+		h.webhookError(c, err, "Failed to delete webhook")
+		return
+	}
+
+	h.l.Info().Int64("webhookID", webhookID).Msg("Webhook success deleted")
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// GetWebhookDeliveries returns the delivery log of a webhook.
+//
+// @Summary Get webhook deliveries
+// @Description Returns the deliveries of a webhook of the authenticated user, the latest first, with the number of attempts and the outcome of the last one
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "Delivery state" Enums(pending, delivered, failed)
+// @Param limit query int false "Maximum number of deliveries, 50 by default" minimum(1) maximum(100)
+// @Success 200 {array} entity.WebhookDelivery "Deliveries"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Webhook not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *CallsHandler) GetWebhookDeliveries(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid webhook ID"})
+		return
+	}
+
+	var input entity.WebhookDeliveriesDTO
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid query parameters"})
+		return
+	}
+
+	deliveries, err := h.u.GetWebhookDeliveries(c.Request.Context(), entity.WebhookDeliveriesQuery{
+		WebhookID: webhookID,
+		UserID:    userID,
+		Status:    input.Status,
+		Limit:     input.Limit,
+	})
+	if err != nil {
+		h.webhookError(c, err, "Failed to get webhook deliveries")
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// RedeliverWebhook sends a logged delivery again.
+//
+// @Summary Redeliver webhook
+// @Description Queues a new delivery with the event and payload of a logged delivery of a webhook of the authenticated user. The new delivery is sent with a fresh signature and retried like any other
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param deliveryID path int true "Delivery ID"
+// @Success 202 {object} entity.WebhookDelivery "Queued delivery"
+// @Failure 400 {object} apierrors.Response "Invalid input"
+// @Failure 401 {object} apierrors.Response "Unauthorized"
+// @Failure 404 {object} apierrors.Response "Webhook or delivery not found"
+// @Failure 500 {object} apierrors.Response "Internal server error"
+// @Router /webhooks/{id}/deliveries/{deliveryID}/redeliver [post]
+func (h *CallsHandler) RedeliverWebhook(c *gin.Context) {
+	userIDAny, exists := c.Get("id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, apierrors.Response{Error: "Unauthorized"})
+		return
+	}
+
+	userID, ok := userIDAny.(int64)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: "Invalid user ID in context"})
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid webhook ID"})
+		return
+	}
+
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryID"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Invalid delivery ID"})
+		return
+	}
+
+	delivery, err := h.u.RedeliverWebhook(c.Request.Context(), webhookID, deliveryID, userID)
+	if err != nil {
+		h.webhookError(c, err, "Failed to redeliver webhook")
+		return
+	}
+
+	h.l.Info().Int64("webhookID", webhookID).Int64("deliveryID", delivery.ID).Msg("Webhook success redelivered")
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func (h *CallsHandler) webhookError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, usecase.ErrWebhookNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Webhook not found"})
+	case errors.Is(err, usecase.ErrDeliveryNotFound):
+		c.JSON(http.StatusNotFound, apierrors.Response{Error: "Delivery not found"})
+	case errors.Is(err, usecase.ErrWebhookHostNotFound):
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Webhook host not found"})
+	case errors.Is(err, usecase.ErrWebhookNotPublic):
+		c.JSON(http.StatusBadRequest, apierrors.Response{Error: "Webhook URL must point to a public address"})
+	case errors.Is(err, usecase.ErrOrgNotFound):
+		c.JSON(http.StatusForbidden, apierrors.Response{Error: "Team webhooks require an active organization"})
+	default:
+		h.l.Error().Err(err).Msg(msg)
+		c.JSON(http.StatusInternalServerError, apierrors.Response{Error: msg})
+	}
+}
@@ -0,0 +1,359 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"calls-service/rest-service/internal/controller"
+	"calls-service/rest-service/internal/controller/apierrors"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/mocks"
+	"calls-service/rest-service/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	tests := []struct {
+		name             string
+		role             string
+		orgID            int64
+		requestBody      string
+		expectedWebhook  entity.Webhook
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:            "Own calls",
+			role:            entity.RoleOperator,
+			requestBody:     `{"url":"https://crm.example.com/hooks/calls","events":["call.created","call.deleted"]}`,
+			expectedWebhook: entity.Webhook{UserID: 123, URL: "https://crm.example.com/hooks/calls", Events: []string{"call.created", "call.deleted"}},
+			expectedStatus:  http.StatusCreated,
+			shouldCallMock:  true,
+		},
+		{
+			name:            "SLA flags",
+			role:            entity.RoleOperator,
+			requestBody:     `{"url":"https://crm.example.com/hooks/calls","events":["call.sla_changed"]}`,
+			expectedWebhook: entity.Webhook{UserID: 123, URL: "https://crm.example.com/hooks/calls", Events: []string{"call.sla_changed"}},
+			expectedStatus:  http.StatusCreated,
+			shouldCallMock:  true,
+		},
+		{
+			name:            "Team calls by supervisor",
+			role:            entity.RoleSupervisor,
+			orgID:           5,
+			requestBody:     `{"url":"https://crm.example.com/hooks/calls","team":true}`,
+			expectedWebhook: entity.Webhook{UserID: 123, URL: "https://crm.example.com/hooks/calls", Team: true, OrgID: 5},
+			expectedStatus:  http.StatusCreated,
+			shouldCallMock:  true,
+		},
+		{
+			name:            "Own calls leave the organization out",
+			role:            entity.RoleSupervisor,
+			orgID:           5,
+			requestBody:     `{"url":"https://crm.example.com/hooks/calls"}`,
+			expectedWebhook: entity.Webhook{UserID: 123, URL: "https://crm.example.com/hooks/calls"},
+			expectedStatus:  http.StatusCreated,
+			shouldCallMock:  true,
+		},
+		{
+			name:             "Team calls without an organization",
+			role:             entity.RoleSupervisor,
+			requestBody:      `{"url":"https://crm.example.com/hooks/calls","team":true}`,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Team webhooks require an active organization"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Team calls after leaving the organization",
+			role:             entity.RoleSupervisor,
+			orgID:            5,
+			requestBody:      `{"url":"https://crm.example.com/hooks/calls","team":true}`,
+			expectedWebhook:  entity.Webhook{UserID: 123, URL: "https://crm.example.com/hooks/calls", Team: true, OrgID: 5},
+			mockErr:          usecase.ErrOrgNotFound,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Team webhooks require an active organization"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Private address",
+			role:             entity.RoleOperator,
+			requestBody:      `{"url":"http://169.254.169.254/latest/meta-data"}`,
+			expectedWebhook:  entity.Webhook{UserID: 123, URL: "http://169.254.169.254/latest/meta-data"},
+			mockErr:          usecase.ErrWebhookNotPublic,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Webhook URL must point to a public address"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Unknown host",
+			role:             entity.RoleOperator,
+			requestBody:      `{"url":"https://crm.invalid/hooks"}`,
+			expectedWebhook:  entity.Webhook{UserID: 123, URL: "https://crm.invalid/hooks"},
+			mockErr:          usecase.ErrWebhookHostNotFound,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Webhook host not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Team calls by operator",
+			role:             entity.RoleOperator,
+			requestBody:      `{"url":"https://crm.example.com/hooks/calls","team":true}`,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: apierrors.Response{Error: "Team webhooks are only for supervisors"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Unknown event",
+			role:             entity.RoleOperator,
+			requestBody:      `{"url":"https://crm.example.com/hooks/calls","events":["call.viewed"]}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+		{
+			name:             "Not an HTTP URL",
+			role:             entity.RoleOperator,
+			requestBody:      `{"url":"ftp://crm.example.com/hooks"}`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid request format"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				var created *entity.Webhook
+				if tt.mockErr == nil {
+					created = &entity.Webhook{}
+					*created = tt.expectedWebhook
+					created.ID = 1
+					created.Secret = "secret"
+				}
+				mockUseCase.On("CreateWebhook", mock.Anything, tt.expectedWebhook).Return(created, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Set("role", tt.role)
+			c.Set("org_id", tt.orgID)
+			c.Request = httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.CreateWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusCreated {
+				var response entity.Webhook
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "secret", response.Secret)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "CreateWebhook")
+			}
+		})
+	}
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		expectedStatus   string
+		mockErr          error
+		expectedCode     int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "All deliveries",
+			expectedCode:   http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:           "Failed deliveries",
+			query:          "?status=failed",
+			expectedStatus: entity.DeliveryFailed,
+			expectedCode:   http.StatusOK,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Webhook not found",
+			mockErr:          usecase.ErrWebhookNotFound,
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Webhook not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Unknown status",
+			query:            "?status=lost",
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid query parameters"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				mockUseCase.On("GetWebhookDeliveries", mock.Anything, entity.WebhookDeliveriesQuery{WebhookID: 1, UserID: 123, Status: tt.expectedStatus}).
+					Return([]entity.WebhookDelivery{{ID: 5, WebhookID: 1, Event: entity.WebhookCallCreated, Payload: json.RawMessage(`{"event":"call.created"}`)}}, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("GET", "/webhooks/1/deliveries"+tt.query, nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.GetWebhookDeliveries(c)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if w.Code == http.StatusOK {
+				var response []entity.WebhookDelivery
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response, 1)
+				assert.JSONEq(t, `{"event":"call.created"}`, string(response[0].Payload))
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "GetWebhookDeliveries")
+			}
+		})
+	}
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	tests := []struct {
+		name             string
+		deliveryID       string
+		mockErr          error
+		expectedStatus   int
+		expectedResponse apierrors.Response
+		shouldCallMock   bool
+	}{
+		{
+			name:           "Successful redelivery",
+			deliveryID:     "5",
+			expectedStatus: http.StatusAccepted,
+			shouldCallMock: true,
+		},
+		{
+			name:             "Delivery not found",
+			deliveryID:       "5",
+			mockErr:          usecase.ErrDeliveryNotFound,
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: apierrors.Response{Error: "Delivery not found"},
+			shouldCallMock:   true,
+		},
+		{
+			name:             "Invalid delivery ID",
+			deliveryID:       "abc",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: apierrors.Response{Error: "Invalid delivery ID"},
+			shouldCallMock:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			if tt.shouldCallMock {
+				redeliveryOf := int64(5)
+				mockUseCase.On("RedeliverWebhook", mock.Anything, int64(1), int64(5), int64(123)).
+					Return(&entity.WebhookDelivery{ID: 6, WebhookID: 1, Status: entity.DeliveryPending, RedeliveryOf: &redeliveryOf}, tt.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}, {Key: "deliveryID", Value: tt.deliveryID}}
+			c.Request = httptest.NewRequest("POST", "/webhooks/1/deliveries/"+tt.deliveryID+"/redeliver", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.RedeliverWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if w.Code == http.StatusAccepted {
+				var response entity.WebhookDelivery
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(6), response.ID)
+				assert.Equal(t, int64(5), *response.RedeliveryOf)
+			} else {
+				var response apierrors.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+
+			if !tt.shouldCallMock {
+				mockUseCase.AssertNotCalled(t, "RedeliverWebhook")
+			}
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "Successful deletion", expectedStatus: http.StatusNoContent},
+		{name: "Webhook not found", mockErr: usecase.ErrWebhookNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.On("DeleteWebhook", mock.Anything, int64(1), int64(123)).Return(tt.mockErr)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("id", int64(123))
+			c.Params = gin.Params{{Key: "id", Value: "1"}}
+			c.Request = httptest.NewRequest("DELETE", "/webhooks/1", nil)
+
+			handler := controller.New(mockUseCase, zerolog.Nop())
+
+			handler.DeleteWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+type AttachmentDTO struct {
+	File *multipart.FileHeader `form:"file" binding:"required"`
+}
+
+// Attachment describes a file attached to a call. The file itself is kept
+// in the attachment storage under StorageKey.
+type Attachment struct {
+	ID          int64     `json:"id"`
+	CallID      int64     `json:"call_id"`
+	UserID      int64     `json:"user_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	StorageKey  string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,53 @@
+package entity
+
+// Bulk request modes. An atomic request is applied entirely or not at all; a
+// best-effort request applies every item that succeeds.
+const (
+	BulkAtomic     = "atomic"
+	BulkBestEffort = "best_effort"
+)
+
+// Outcomes of a single bulk item.
+const (
+	BulkItemOK      = "ok"
+	BulkItemFailed  = "failed"
+	BulkItemSkipped = "skipped"
+)
+
+// BulkCreateCallsDTO creates Items. Force creates the items that repeat a
+// recent open call as well.
+type BulkCreateCallsDTO struct {
+	Mode  string    `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Force bool      `json:"force"`
+	Items []CallDTO `json:"items" binding:"required,min=1,max=100"`
+}
+
+type BulkStatusItemDTO struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+type BulkUpdateStatusDTO struct {
+	Mode  string              `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Items []BulkStatusItemDTO `json:"items" binding:"required,min=1,max=100"`
+}
+
+type BulkDeleteDTO struct {
+	Mode string  `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	IDs  []int64 `json:"ids" binding:"required,min=1,max=100"`
+}
+
+// BulkItemResult reports the outcome of the item at Index of a bulk request.
+// Skipped items were valid but not applied because an atomic request failed.
+type BulkItemResult struct {
+	Index  int    `json:"index"`
+	ID     int64  `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BulkResponse struct {
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []BulkItemResult `json:"items"`
+}
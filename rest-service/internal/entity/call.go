@@ -6,32 +6,178 @@ type CallDTO struct {
 	ClientName  string `json:"client_name" binding:"required"`
 	PhoneNumber string `json:"phone_number" binding:"required"`
 	Description string `json:"description" binding:"required"`
+	Priority    string `json:"priority" binding:"omitempty,oneof=low normal high critical"`
+}
+
+// SaveCallQueryDTO holds the options of call creation. Force creates the call
+// even if it looks like a duplicate.
+type SaveCallQueryDTO struct {
+	Force bool `form:"force"`
+}
+
+type CallsFilterDTO struct {
+	Limit       int       `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor      string    `form:"cursor"`
+	Status      string    `form:"status"`
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	PhoneNumber string    `form:"phone_number"`
+	ClientName  string    `form:"client_name"`
+	AssignedTo  string    `form:"assigned_to" binding:"omitempty,oneof=me"`
+	Priority    string    `form:"priority" binding:"omitempty,oneof=low normal high critical"`
+	Tag         string    `form:"tag"`
+	SLA         string    `form:"sla" binding:"omitempty,oneof=ok at_risk breached"`
+	Sort        string    `form:"sort" binding:"omitempty,oneof=created_at client_name status id"`
+	Order       string    `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// ExportCallsDTO takes the filters and sort order of the list endpoint; paging
+// parameters are ignored.
+type ExportCallsDTO struct {
+	CallsFilterDTO
+	Format string `form:"format" binding:"required,oneof=csv jsonl xlsx"`
+}
+
+type SearchCallsDTO struct {
+	Q     string `form:"q" binding:"required"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// UpdateCallDTO is a partial update: only non-nil fields are changed.
+type UpdateCallDTO struct {
+	ClientName  *string `json:"client_name" binding:"omitempty,min=1"`
+	PhoneNumber *string `json:"phone_number" binding:"omitempty,min=1"`
+	Description *string `json:"description" binding:"omitempty,min=1"`
+	Priority    *string `json:"priority" binding:"omitempty,oneof=low normal high critical"`
+}
+
+type MergeCallsDTO struct {
+	DuplicateIDs []int64 `json:"duplicate_ids" binding:"required,min=1,max=20,dive,min=1"`
+}
+
+// CallMerge folds the calls DuplicateIDs into call CallID.
+type CallMerge struct {
+	CallID       int64
+	UserID       int64
+	OrgID        int64
+	DuplicateIDs []int64
+}
+
+type AssignCallDTO struct {
+	AssigneeID int64 `json:"assignee_id" binding:"required,min=1"`
 }
 
 type UpdateCallStatusDTO struct {
 	Status string `json:"status" binding:"required"`
 }
 
+// CallResponse carries the phone number as it was entered, in E.164 and in
+// the display format of its country. Calls entered before numbers were
+// normalized may lack the last two.
 type CallResponse struct {
-	ID          int64     `json:"id"`
-	ClientName  string    `json:"client_name"`
-	PhoneNumber string    `json:"phone_number"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID            int64      `json:"id"`
+	ClientName    string     `json:"client_name"`
+	PhoneNumber   string     `json:"phone_number"`
+	PhoneE164     string     `json:"phone_e164,omitempty"`
+	PhoneNational string     `json:"phone_national,omitempty"`
+	Description   string     `json:"description"`
+	Status        string     `json:"status"`
+	StatusLabel   string     `json:"status_label"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Version       int64      `json:"version"`
+	AssigneeID    *int64     `json:"assignee_id,omitempty"`
+	Priority      string     `json:"priority"`
+	DueAt         *time.Time `json:"due_at,omitempty"`
+	SLAStatus     string     `json:"sla_status"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	ClosedAt      *time.Time `json:"closed_at,omitempty"`
+	Tags          []string   `json:"tags"`
+	ClientID      *int64     `json:"client_id,omitempty"`
+	// CallbackAt is given in CallbackTimezone.
+	CallbackAt       *time.Time `json:"callback_at,omitempty"`
+	CallbackTimezone string     `json:"callback_timezone,omitempty"`
+}
+
+type CallsListResponse struct {
+	Items      []CallResponse `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+type CallSearchResult struct {
+	CallResponse
+	Rank      float64       `json:"rank"`
+	Highlight CallHighlight `json:"highlight"`
+}
+
+// CallHighlight holds text fragments with matched words wrapped in <mark> tags.
+// The rest of the text is HTML-escaped, so the fragments are safe to render as
+// HTML.
+type CallHighlight struct {
+	ClientName  string `json:"client_name"`
+	Description string `json:"description"`
 }
 
+// Call is a new call. PhoneE164 is PhoneNumber in E.164 format. DueIn is the
+// SLA time allowed to resolve it, counted from creation. A non-zero
+// DuplicateWithin refuses the call if an open call with the same number,
+// visible to UserID, was created within that time. The call belongs to OrgID,
+// the organization active in the session of UserID, if UserID is a member
+// of it.
 type Call struct {
-	ID          int64     `json:"id"`
-	ClientName  string    `json:"client_name"`
-	PhoneNumber string    `json:"phone_number"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UserID      int64     `json:"user_id"`
+	ID              int64         `json:"id"`
+	ClientName      string        `json:"client_name"`
+	PhoneNumber     string        `json:"phone_number"`
+	PhoneE164       string        `json:"phone_e164"`
+	Description     string        `json:"description"`
+	Status          string        `json:"status"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UserID          int64         `json:"user_id"`
+	OrgID           int64         `json:"org_id"`
+	Priority        string        `json:"priority"`
+	DueIn           time.Duration `json:"-"`
+	DuplicateWithin time.Duration `json:"-"`
 }
 
+// CallUpdate changes the given fields of a call if it still has the expected version,
+// or whatever its version if Version is zero.
+// DueIn is the SLA of the new priority and moves the deadline when set;
+// WarnBefore is how long before the new deadline the call is at risk.
+type CallUpdate struct {
+	ID          int64
+	UserID      int64
+	OrgID       int64
+	Version     int64
+	ClientName  *string
+	PhoneNumber *string
+	PhoneE164   *string
+	Description *string
+	Priority    *string
+	DueIn       *time.Duration
+	WarnBefore  time.Duration
+}
+
+// Assignment hands a call to AssigneeID; a nil AssigneeID unassigns it.
+type Assignment struct {
+	CallID     int64
+	UserID     int64
+	OrgID      int64
+	AssigneeID *int64
+}
+
+// User roles carried in the JWT. Supervisors see the statistics of the whole team.
+const (
+	RoleOperator   = "operator"
+	RoleSupervisor = "supervisor"
+)
+
 type AuthRequest struct {
 	Username string `json:"username" binding:"required"`
 	Password string `json:"password" binding:"required"`
 }
+
+// User is a user of the auth service.
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
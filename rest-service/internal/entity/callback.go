@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+// ScheduleCallbackDTO takes the callback time either with an offset, in RFC
+// 3339 format, or as a local time in Timezone, an IANA time zone name.
+type ScheduleCallbackDTO struct {
+	At       string `json:"at" binding:"required"`
+	Timezone string `json:"timezone"`
+}
+
+type SnoozeCallbackDTO struct {
+	Minutes int `json:"minutes" binding:"required,min=1,max=10080"`
+}
+
+// UpcomingCallbacksDTO selects the callbacks due within the given time, 24
+// hours by default, including overdue ones.
+type UpcomingCallbacksDTO struct {
+	Within time.Duration `form:"within" binding:"omitempty,min=1m,max=720h"`
+	Limit  int           `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// Callback schedules a call back to the client at At, which is kept in
+// Timezone.
+type Callback struct {
+	CallID   int64
+	UserID   int64
+	OrgID    int64
+	At       time.Time
+	Timezone string
+}
+
+// CallbackSnooze postpones the callback of a call by By, counted from the
+// callback time or from now if it is already overdue.
+type CallbackSnooze struct {
+	CallID int64
+	UserID int64
+	OrgID  int64
+	By     time.Duration
+}
+
+// CallbacksQuery selects the open calls visible to UserID with a callback due
+// before Before.
+type CallbacksQuery struct {
+	UserID int64
+	OrgID  int64
+	Before time.Time
+	Limit  int
+}
+
+// CallbackDue is a callback the reminder worker has just found due.
+type CallbackDue struct {
+	CallID     int64
+	UserID     int64
+	AssigneeID *int64
+	CallbackAt time.Time
+	Timezone   string
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+type ClientsFilterDTO struct {
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor"`
+	Q      string `form:"q"`
+}
+
+// Client is a customer identified by phone number in E.164 format. Name,
+// CreatedAt, CallsCount and LastCallAt come only from the active calls
+// visible to the requesting user: Name is the client name of the latest of
+// them and CreatedAt the time of the first.
+type Client struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	PhoneNumber   string    `json:"phone_number"`
+	PhoneNational string    `json:"phone_national"`
+	CreatedAt     time.Time `json:"created_at"`
+	CallsCount    int64     `json:"calls_count"`
+	LastCallAt    time.Time `json:"last_call_at"`
+}
+
+type ClientsListResponse struct {
+	Items      []Client `json:"items"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// ClientsQuery describes a page of the clients of UserID's calls, newest
+// first. Q matches part of the phone number or of the client name of one of
+// the visible calls.
+type ClientsQuery struct {
+	UserID int64
+	OrgID  int64
+	Limit  int
+	After  *ClientsCursor
+	Q      string
+}
+
+// ClientsCursor points at the last client of the previous page.
+type ClientsCursor struct {
+	ID int64 `json:"id"`
+}
+
+type ClientsPage struct {
+	Items []Client
+	Next  *ClientsCursor
+}
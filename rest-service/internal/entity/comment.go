@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+type CommentDTO struct {
+	Body       string `json:"body" binding:"required"`
+	IsInternal bool   `json:"is_internal"`
+}
+
+type UpdateCommentDTO struct {
+	Body       *string `json:"body" binding:"omitempty,min=1"`
+	IsInternal *bool   `json:"is_internal"`
+}
+
+type CommentsFilterDTO struct {
+	Internal *bool `form:"internal"`
+}
+
+// Comment is a follow-up on a call. Internal comments are notes for
+// operators and must never be shown to the client.
+type Comment struct {
+	ID         int64      `json:"id"`
+	CallID     int64      `json:"call_id"`
+	AuthorID   int64      `json:"author_id"`
+	Body       string     `json:"body"`
+	IsInternal bool       `json:"is_internal"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	EditCount  int        `json:"edit_count"`
+}
+
+// CommentUpdate changes the given fields of a comment on behalf of its author.
+type CommentUpdate struct {
+	ID         int64
+	CallID     int64
+	AuthorID   int64
+	OrgID      int64
+	Body       *string
+	IsInternal *bool
+}
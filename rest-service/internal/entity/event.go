@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Call event types recorded in call_events.
+const (
+	EventCreated       = "created"
+	EventUpdated       = "updated"
+	EventStatusChanged = "status_changed"
+	EventDeleted       = "deleted"
+	EventRestored      = "restored"
+	EventAssigned      = "assigned"
+	EventUnassigned    = "unassigned"
+	EventTagged        = "tagged"
+	EventUntagged      = "untagged"
+	EventMerged        = "merged"
+
+	EventCallbackScheduled = "callback_scheduled"
+	EventCallbackSnoozed   = "callback_snoozed"
+	EventCallbackCancelled = "callback_cancelled"
+	EventCallbackDue       = "callback_due"
+)
+
+// CallEvent is a single field-level change of a call. Field is empty and
+// both values are nil for events that do not touch a particular field.
+// UserID is zero for events recorded by the service itself.
+type CallEvent struct {
+	ID        int64     `json:"id"`
+	CallID    int64     `json:"call_id"`
+	UserID    int64     `json:"user_id"`
+	Type      string    `json:"event_type"`
+	Field     string    `json:"field,omitempty"`
+	OldValue  *string   `json:"old_value,omitempty"`
+	NewValue  *string   `json:"new_value,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package entity
+
+// GraphQLRequest is an operation sent to POST /graphql, or the payload of a
+// subscribe message over its WebSocket.
+type GraphQLRequest struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
@@ -0,0 +1,68 @@
+package entity
+
+import "mime/multipart"
+
+// Call fields that can be loaded from an import file.
+const (
+	ImportClientName  = "client_name"
+	ImportPhoneNumber = "phone_number"
+	ImportDescription = "description"
+	ImportPriority    = "priority"
+	ImportStatus      = "status"
+	ImportCreatedAt   = "created_at"
+)
+
+// ImportCallsDTO is a multipart import request. Mapping is a JSON object from
+// call field to file column header; unmapped fields are looked up by their own name.
+// Force imports the rows that repeat a recent open call as well.
+type ImportCallsDTO struct {
+	File      *multipart.FileHeader `form:"file" binding:"required"`
+	Mapping   string                `form:"mapping"`
+	Delimiter string                `form:"delimiter"`
+	DryRun    bool                  `form:"dry_run"`
+	Force     bool                  `form:"force"`
+}
+
+// CallSource yields calls one at a time so that a large import is never held
+// in memory as a whole. Row is the file row of the current call.
+type CallSource interface {
+	Next() bool
+	Call() Call
+	Row() int
+	Err() error
+}
+
+// ImportResult sums up the calls imported from a source. Duplicates are the
+// calls left out because they repeat a recent open call.
+type ImportResult struct {
+	Imported   int64
+	Duplicates []ImportDuplicate
+}
+
+// ImportDuplicate is a row of an import file that repeats the open call
+// CallID, which may come from an earlier row.
+type ImportDuplicate struct {
+	Row    int
+	CallID int64
+}
+
+// ImportRowError describes an invalid value of a file row. Row counts from 1
+// and includes the header.
+type ImportRowError struct {
+	Row    int    `json:"row"`
+	Column string `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// ImportReport sums up an import. Invalid rows and valid rows repeating a
+// recent open call are skipped and listed in Errors; a dry run validates the
+// file without importing the valid rows.
+type ImportReport struct {
+	DryRun     bool             `json:"dry_run"`
+	TotalRows  int              `json:"total_rows"`
+	Valid      int              `json:"valid"`
+	Imported   int64            `json:"imported"`
+	Duplicates int              `json:"duplicates"`
+	Failed     int              `json:"failed"`
+	Errors     []ImportRowError `json:"errors"`
+}
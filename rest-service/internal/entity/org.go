@@ -0,0 +1,60 @@
+package entity
+
+import "time"
+
+// Membership roles. The owner of an organization invites and removes its
+// members.
+const (
+	OrgOwner  = "owner"
+	OrgMember = "member"
+)
+
+type OrganizationDTO struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type InvitationDTO struct {
+	UserID int64 `json:"user_id" binding:"required,min=1"`
+}
+
+// SwitchOrgDTO selects the organization active in a new session of the user,
+// or none with a zero OrgID.
+type SwitchOrgDTO struct {
+	OrgID int64 `json:"org_id" binding:"min=0"`
+}
+
+// Organization shares the calls of its members among them. Role is the
+// membership of the user it was requested by, and Active tells whether it is
+// the organization active in the session it was requested in.
+type Organization struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Member struct {
+	UserID   int64     `json:"user_id"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// Invitation asks UserID to join an organization. It is gone once accepted
+// or declined.
+type Invitation struct {
+	ID        int64     `json:"id"`
+	OrgID     int64     `json:"org_id"`
+	OrgName   string    `json:"org_name"`
+	UserID    int64     `json:"user_id"`
+	InvitedBy int64     `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MemberRemoval removes MemberID from an organization on behalf of UserID,
+// who is its owner or the member leaving.
+type MemberRemoval struct {
+	OrgID    int64
+	MemberID int64
+	UserID   int64
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Outbox event types of changes that are not recorded in call_events.
+const (
+	EventSLAChanged = "sla_changed"
+	EventPurged     = "purged"
+)
+
+// CallChange is the message published about a change of a call. Event is a
+// call event type, and Changes holds the fields the change touched in the
+// order of the call history. UserID is zero for changes made by the service
+// itself. OwnerID, AssigneeID and OrgID are the creator, the assignee and the
+// organization of the call after the change, so that its subscribers can be
+// found after it is deleted for good.
+type CallChange struct {
+	Event      string        `json:"event"`
+	CallID     int64         `json:"call_id"`
+	UserID     int64         `json:"user_id"`
+	OwnerID    int64         `json:"owner_id"`
+	AssigneeID *int64        `json:"assignee_id,omitempty"`
+	OrgID      int64         `json:"org_id,omitempty"`
+	Changes    []FieldChange `json:"changes,omitempty"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+type FieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value,omitempty"`
+	NewValue *string `json:"new_value,omitempty"`
+}
+
+// OutboxMessage is a call change written to the outbox in the transaction of
+// the change and waiting to be published. Payload is the encoded CallChange.
+type OutboxMessage struct {
+	ID        int64
+	CallID    int64
+	Event     string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+const (
+	SortByCreatedAt  = "created_at"
+	SortByClientName = "client_name"
+	SortByStatus     = "status"
+	SortByID         = "id"
+)
+
+// CallsQuery describes a single page request for the list of user calls.
+// A non-zero AssigneeID keeps only calls assigned to that user and a non-zero
+// ClientID only calls of that client; Tag keeps calls with a tag of that name
+// in any case; Deleted lists the trash instead of active calls. OrgID is the
+// organization active in the session of UserID, whose calls are listed as
+// well while UserID is a member of it.
+type CallsQuery struct {
+	UserID      int64
+	OrgID       int64
+	AssigneeID  int64
+	ClientID    int64
+	Limit       int
+	After       *CallsCursor
+	Status      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	PhoneNumber string
+	ClientName  string
+	Priority    string
+	SLAStatus   string
+	Tag         string
+	SortBy      string
+	SortDesc    bool
+	Deleted     bool
+}
+
+// CallsCursor points at the last call of the previous page.
+type CallsCursor struct {
+	SortBy string `json:"s"`
+	Value  string `json:"v"`
+	ID     int64  `json:"id"`
+}
+
+type CallsSearchQuery struct {
+	UserID int64
+	OrgID  int64
+	Text   string
+	Limit  int
+}
+
+type CallsPage struct {
+	Items []CallResponse
+	Next  *CallsCursor
+}
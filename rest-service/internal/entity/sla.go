@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// Call priorities, from the least to the most urgent.
+const (
+	PriorityLow      = "low"
+	PriorityNormal   = "normal"
+	PriorityHigh     = "high"
+	PriorityCritical = "critical"
+)
+
+// SLA states of a call set by the SLA worker.
+const (
+	SLAOk       = "ok"
+	SLAAtRisk   = "at_risk"
+	SLABreached = "breached"
+)
+
+// SLAFlag is a call whose SLA state has just been changed by the SLA worker.
+type SLAFlag struct {
+	CallID     int64
+	UserID     int64
+	AssigneeID *int64
+	SLAStatus  string
+	DueAt      time.Time
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// Statistics scopes: the calls visible to the user or the calls of the whole
+// organization.
+const (
+	StatsScopeOwn  = "own"
+	StatsScopeTeam = "team"
+)
+
+// StatsDTO selects the days of the statistics, both inclusive.
+type StatsDTO struct {
+	From time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To   time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+}
+
+// StatsQuery describes the statistics of the calls visible to UserID in
+// organization OrgID, or of all calls of the organization if Team is set, over
+// the days From to To inclusive. Days are counted in UTC.
+type StatsQuery struct {
+	UserID int64
+	OrgID  int64
+	Team   bool
+	From   time.Time
+	To     time.Time
+}
+
+// CallStats covers the active calls of the scope. Open and ByStatus count the
+// calls as they are now; Daily and Resolution cover the requested days.
+type CallStats struct {
+	Scope      string          `json:"scope"`
+	From       string          `json:"from"`
+	To         string          `json:"to"`
+	Open       int64           `json:"open"`
+	ByStatus   []StatusCount   `json:"by_status"`
+	Daily      []DailyCount    `json:"daily"`
+	Resolution ResolutionStats `json:"resolution"`
+}
+
+type StatusCount struct {
+	Status string `json:"status"`
+	Label  string `json:"label"`
+	Count  int64  `json:"count"`
+}
+
+// DailyCount holds the numbers of calls created and closed on a day.
+type DailyCount struct {
+	Date    string `json:"date"`
+	Created int64  `json:"created"`
+	Closed  int64  `json:"closed"`
+}
+
+// ResolutionStats describes the time from creation to closing, in seconds,
+// of the calls closed over the requested days. The times are null if no call
+// was closed.
+type ResolutionStats struct {
+	Closed     int64    `json:"closed"`
+	AvgSeconds *float64 `json:"avg_seconds"`
+	P50Seconds *float64 `json:"p50_seconds"`
+	P90Seconds *float64 `json:"p90_seconds"`
+	P95Seconds *float64 `json:"p95_seconds"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+// Call status codes stored in calls.status. Human-readable labels live in
+// the call_statuses table.
+const (
+	StatusNew        = "new"
+	StatusInProgress = "in_progress"
+	StatusOnHold     = "on_hold"
+	StatusResolved   = "resolved"
+	StatusClosed     = "closed"
+	StatusReopened   = "reopened"
+)
+
+// StatusChange moves a call from one status to another.
+type StatusChange struct {
+	CallID int64
+	UserID int64
+	OrgID  int64
+	From   string
+	To     string
+}
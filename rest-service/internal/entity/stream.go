@@ -0,0 +1,32 @@
+package entity
+
+// Types of call stream events.
+const (
+	StreamCallCreated = "created"
+	StreamCallUpdated = "updated"
+	StreamCallDeleted = "deleted"
+)
+
+// CallNotification announces a change of a call to every instance of the
+// service. ID is the outbox message of the change, OrgID the organization of
+// the call, zero if none, and Viewers the users who created the call or were
+// assigned it before or after the change.
+type CallNotification struct {
+	ID      int64   `json:"id"`
+	CallID  int64   `json:"call_id"`
+	Event   string  `json:"event"`
+	OrgID   int64   `json:"org_id"`
+	Viewers []int64 `json:"viewers"`
+}
+
+// CallStreamEvent is a change of a call pushed to the live stream of a user.
+// Type is created for a call that came into view, deleted for one that left
+// it, and updated otherwise; Event is the call event type behind it. Call is
+// the call after the change, unless it was deleted.
+type CallStreamEvent struct {
+	ID     int64         `json:"id"`
+	Type   string        `json:"type"`
+	Event  string        `json:"event"`
+	CallID int64         `json:"call_id"`
+	Call   *CallResponse `json:"call,omitempty"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type TagDTO struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type AttachTagDTO struct {
+	TagID int64 `json:"tag_id" binding:"required,min=1"`
+}
+
+// Tag belongs to the catalogue of the user who created it. Names are unique
+// within a catalogue regardless of case.
+type Tag struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TagChange attaches a tag to or detaches it from a call on behalf of UserID.
+type TagChange struct {
+	CallID int64
+	TagID  int64
+	UserID int64
+	OrgID  int64
+}
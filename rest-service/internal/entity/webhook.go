@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Call lifecycle events sent to webhooks.
+const (
+	WebhookCallCreated       = "call.created"
+	WebhookCallUpdated       = "call.updated"
+	WebhookCallStatusChanged = "call.status_changed"
+	WebhookCallDeleted       = "call.deleted"
+	WebhookCallRestored      = "call.restored"
+	WebhookCallSLAChanged    = "call.sla_changed"
+)
+
+// WebhookEvents maps the call event types sent to webhooks to their webhook
+// events. The changes of the other types are not sent.
+var WebhookEvents = map[string]string{
+	EventCreated:           WebhookCallCreated,
+	EventUpdated:           WebhookCallUpdated,
+	EventAssigned:          WebhookCallUpdated,
+	EventUnassigned:        WebhookCallUpdated,
+	EventTagged:            WebhookCallUpdated,
+	EventUntagged:          WebhookCallUpdated,
+	EventMerged:            WebhookCallUpdated,
+	EventCallbackScheduled: WebhookCallUpdated,
+	EventCallbackSnoozed:   WebhookCallUpdated,
+	EventCallbackCancelled: WebhookCallUpdated,
+	EventStatusChanged:     WebhookCallStatusChanged,
+	EventDeleted:           WebhookCallDeleted,
+	EventRestored:          WebhookCallRestored,
+	EventSLAChanged:        WebhookCallSLAChanged,
+}
+
+// Webhook delivery states.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookDTO subscribes url to the given events, all of them if none are
+// listed. Team subscribes to the calls of the whole team instead of the calls
+// visible to the user.
+type WebhookDTO struct {
+	URL    string   `json:"url" binding:"required,http_url,max=2048"`
+	Events []string `json:"events" binding:"omitempty,max=6,dive,oneof=call.created call.updated call.status_changed call.deleted call.restored call.sla_changed"`
+	Team   bool     `json:"team"`
+}
+
+type WebhookDeliveriesDTO struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending delivered failed"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// Webhook is a subscription of UserID to call events. A team webhook receives
+// the events of the calls of OrgID. Secret signs the deliveries and is only
+// returned when the webhook is created.
+type Webhook struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Team      bool      `json:"team"`
+	OrgID     int64     `json:"org_id,omitempty"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookPayload is the body of a delivery. Call is the call as it is when
+// the delivery is queued and is left out of call.deleted events.
+type WebhookPayload struct {
+	Event      string        `json:"event"`
+	OccurredAt time.Time     `json:"occurred_at"`
+	UserID     int64         `json:"user_id"`
+	CallID     int64         `json:"call_id"`
+	Call       *CallResponse `json:"call,omitempty"`
+}
+
+// WebhookDelivery is an entry of the delivery log of a webhook. Attempts
+// counts the requests sent so far; a pending delivery is sent again at
+// NextAttemptAt.
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhook_id"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	LastError      *string         `json:"last_error,omitempty"`
+	RedeliveryOf   *int64          `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	URL            string          `json:"-"`
+	Secret         string          `json:"-"`
+}
+
+type WebhookDeliveriesQuery struct {
+	WebhookID int64
+	UserID    int64
+	Status    string
+	Limit     int
+}
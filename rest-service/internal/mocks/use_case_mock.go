@@ -5,6 +5,8 @@ package mocks
 import (
 	entity "calls-service/rest-service/internal/entity"
 	context "context"
+	io "io"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -22,77 +24,2937 @@ func (_m *MockUseCase) EXPECT() *MockUseCase_Expecter {
 	return &MockUseCase_Expecter{mock: &_m.Mock}
 }
 
-// DeleteCall provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockUseCase) DeleteCall(_a0 context.Context, _a1 int64, _a2 int64) error {
+// AcceptInvitation provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) AcceptInvitation(_a0 context.Context, _a1 int64, _a2 int64) (*entity.Organization, error) {
 	ret := _m.Called(_a0, _a1, _a2)
 
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 *entity.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (*entity.Organization, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *entity.Organization); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AcceptInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvitation'
+type MockUseCase_AcceptInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptInvitation is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) AcceptInvitation(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_AcceptInvitation_Call {
+	return &MockUseCase_AcceptInvitation_Call{Call: _e.mock.On("AcceptInvitation", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_AcceptInvitation_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_AcceptInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AcceptInvitation_Call) Return(_a0 *entity.Organization, _a1 error) *MockUseCase_AcceptInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AcceptInvitation_Call) RunAndReturn(run func(context.Context, int64, int64) (*entity.Organization, error)) *MockUseCase_AcceptInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddAttachment provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) AddAttachment(_a0 context.Context, _a1 entity.Attachment, _a2 int64, _a3 io.Reader) (*entity.Attachment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 *entity.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Attachment, int64, io.Reader) (*entity.Attachment, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Attachment, int64, io.Reader) *entity.Attachment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Attachment, int64, io.Reader) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type MockUseCase_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.Attachment
+//   - _a2 int64
+//   - _a3 io.Reader
+func (_e *MockUseCase_Expecter) AddAttachment(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_AddAttachment_Call {
+	return &MockUseCase_AddAttachment_Call{Call: _e.mock.On("AddAttachment", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_AddAttachment_Call) Run(run func(_a0 context.Context, _a1 entity.Attachment, _a2 int64, _a3 io.Reader)) *MockUseCase_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Attachment), args[2].(int64), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AddAttachment_Call) Return(_a0 *entity.Attachment, _a1 error) *MockUseCase_AddAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AddAttachment_Call) RunAndReturn(run func(context.Context, entity.Attachment, int64, io.Reader) (*entity.Attachment, error)) *MockUseCase_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddComment provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) AddComment(_a0 context.Context, _a1 int64, _a2 int64, _a3 entity.Comment) (*entity.Comment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 *entity.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, entity.Comment) (*entity.Comment, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, entity.Comment) *entity.Comment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, entity.Comment) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type MockUseCase_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 entity.Comment
+func (_e *MockUseCase_Expecter) AddComment(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_AddComment_Call {
+	return &MockUseCase_AddComment_Call{Call: _e.mock.On("AddComment", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_AddComment_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 entity.Comment)) *MockUseCase_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(entity.Comment))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AddComment_Call) Return(_a0 *entity.Comment, _a1 error) *MockUseCase_AddComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AddComment_Call) RunAndReturn(run func(context.Context, int64, int64, entity.Comment) (*entity.Comment, error)) *MockUseCase_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignCall provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) AssignCall(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCall")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AssignCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCall'
+type MockUseCase_AssignCall_Call struct {
+	*mock.Call
+}
+
+// AssignCall is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+//   - _a4 int64
+func (_e *MockUseCase_Expecter) AssignCall(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_AssignCall_Call {
+	return &MockUseCase_AssignCall_Call{Call: _e.mock.On("AssignCall", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_AssignCall_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64)) *MockUseCase_AssignCall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AssignCall_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_AssignCall_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AssignCall_Call) RunAndReturn(run func(context.Context, int64, int64, int64, int64) (*entity.CallResponse, error)) *MockUseCase_AssignCall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachTag provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) AttachTag(_a0 context.Context, _a1 entity.TagChange) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachTag")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TagChange) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TagChange) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.TagChange) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_AttachTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachTag'
+type MockUseCase_AttachTag_Call struct {
+	*mock.Call
+}
+
+// AttachTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.TagChange
+func (_e *MockUseCase_Expecter) AttachTag(_a0 interface{}, _a1 interface{}) *MockUseCase_AttachTag_Call {
+	return &MockUseCase_AttachTag_Call{Call: _e.mock.On("AttachTag", _a0, _a1)}
+}
+
+func (_c *MockUseCase_AttachTag_Call) Run(run func(_a0 context.Context, _a1 entity.TagChange)) *MockUseCase_AttachTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.TagChange))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_AttachTag_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_AttachTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_AttachTag_Call) RunAndReturn(run func(context.Context, entity.TagChange) (*entity.CallResponse, error)) *MockUseCase_AttachTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkCreateCalls provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) BulkCreateCalls(_a0 context.Context, _a1 []entity.Call, _a2 bool, _a3 bool) ([]entity.BulkItemResult, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkCreateCalls")
+	}
+
+	var r0 []entity.BulkItemResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Call, bool, bool) ([]entity.BulkItemResult, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Call, bool, bool) []entity.BulkItemResult); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BulkItemResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []entity.Call, bool, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_BulkCreateCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateCalls'
+type MockUseCase_BulkCreateCalls_Call struct {
+	*mock.Call
+}
+
+// BulkCreateCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []entity.Call
+//   - _a2 bool
+//   - _a3 bool
+func (_e *MockUseCase_Expecter) BulkCreateCalls(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_BulkCreateCalls_Call {
+	return &MockUseCase_BulkCreateCalls_Call{Call: _e.mock.On("BulkCreateCalls", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_BulkCreateCalls_Call) Run(run func(_a0 context.Context, _a1 []entity.Call, _a2 bool, _a3 bool)) *MockUseCase_BulkCreateCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.Call), args[2].(bool), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_BulkCreateCalls_Call) Return(_a0 []entity.BulkItemResult, _a1 error) *MockUseCase_BulkCreateCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_BulkCreateCalls_Call) RunAndReturn(run func(context.Context, []entity.Call, bool, bool) ([]entity.BulkItemResult, error)) *MockUseCase_BulkCreateCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkDeleteCalls provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) BulkDeleteCalls(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64, _a4 bool) ([]entity.BulkItemResult, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkDeleteCalls")
+	}
+
+	var r0 []entity.BulkItemResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64, bool) ([]entity.BulkItemResult, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64, bool) []entity.BulkItemResult); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BulkItemResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_BulkDeleteCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDeleteCalls'
+type MockUseCase_BulkDeleteCalls_Call struct {
+	*mock.Call
+}
+
+// BulkDeleteCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 []int64
+//   - _a4 bool
+func (_e *MockUseCase_Expecter) BulkDeleteCalls(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_BulkDeleteCalls_Call {
+	return &MockUseCase_BulkDeleteCalls_Call{Call: _e.mock.On("BulkDeleteCalls", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_BulkDeleteCalls_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64, _a4 bool)) *MockUseCase_BulkDeleteCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_BulkDeleteCalls_Call) Return(_a0 []entity.BulkItemResult, _a1 error) *MockUseCase_BulkDeleteCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_BulkDeleteCalls_Call) RunAndReturn(run func(context.Context, int64, int64, []int64, bool) ([]entity.BulkItemResult, error)) *MockUseCase_BulkDeleteCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateCallStatus provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) BulkUpdateCallStatus(_a0 context.Context, _a1 int64, _a2 int64, _a3 []entity.StatusChange, _a4 bool) ([]entity.BulkItemResult, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateCallStatus")
+	}
+
+	var r0 []entity.BulkItemResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []entity.StatusChange, bool) ([]entity.BulkItemResult, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []entity.StatusChange, bool) []entity.BulkItemResult); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.BulkItemResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []entity.StatusChange, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_BulkUpdateCallStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateCallStatus'
+type MockUseCase_BulkUpdateCallStatus_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateCallStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 []entity.StatusChange
+//   - _a4 bool
+func (_e *MockUseCase_Expecter) BulkUpdateCallStatus(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_BulkUpdateCallStatus_Call {
+	return &MockUseCase_BulkUpdateCallStatus_Call{Call: _e.mock.On("BulkUpdateCallStatus", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_BulkUpdateCallStatus_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 []entity.StatusChange, _a4 bool)) *MockUseCase_BulkUpdateCallStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]entity.StatusChange), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_BulkUpdateCallStatus_Call) Return(_a0 []entity.BulkItemResult, _a1 error) *MockUseCase_BulkUpdateCallStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_BulkUpdateCallStatus_Call) RunAndReturn(run func(context.Context, int64, int64, []entity.StatusChange, bool) ([]entity.BulkItemResult, error)) *MockUseCase_BulkUpdateCallStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelCallback provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) CancelCallback(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCallback")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CancelCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCallback'
+type MockUseCase_CancelCallback_Call struct {
+	*mock.Call
+}
+
+// CancelCallback is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) CancelCallback(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_CancelCallback_Call {
+	return &MockUseCase_CancelCallback_Call{Call: _e.mock.On("CancelCallback", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_CancelCallback_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_CancelCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CancelCallback_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_CancelCallback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CancelCallback_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.CallResponse, error)) *MockUseCase_CancelCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrg provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) CreateOrg(_a0 context.Context, _a1 string, _a2 int64) (*entity.Organization, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrg")
+	}
+
+	var r0 *entity.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*entity.Organization, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *entity.Organization); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CreateOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrg'
+type MockUseCase_CreateOrg_Call struct {
+	*mock.Call
+}
+
+// CreateOrg is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) CreateOrg(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_CreateOrg_Call {
+	return &MockUseCase_CreateOrg_Call{Call: _e.mock.On("CreateOrg", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_CreateOrg_Call) Run(run func(_a0 context.Context, _a1 string, _a2 int64)) *MockUseCase_CreateOrg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CreateOrg_Call) Return(_a0 *entity.Organization, _a1 error) *MockUseCase_CreateOrg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CreateOrg_Call) RunAndReturn(run func(context.Context, string, int64) (*entity.Organization, error)) *MockUseCase_CreateOrg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) CreateTag(_a0 context.Context, _a1 entity.Tag) (*entity.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Tag) (*entity.Tag, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Tag) *entity.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Tag) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockUseCase_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.Tag
+func (_e *MockUseCase_Expecter) CreateTag(_a0 interface{}, _a1 interface{}) *MockUseCase_CreateTag_Call {
+	return &MockUseCase_CreateTag_Call{Call: _e.mock.On("CreateTag", _a0, _a1)}
+}
+
+func (_c *MockUseCase_CreateTag_Call) Run(run func(_a0 context.Context, _a1 entity.Tag)) *MockUseCase_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Tag))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CreateTag_Call) Return(_a0 *entity.Tag, _a1 error) *MockUseCase_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CreateTag_Call) RunAndReturn(run func(context.Context, entity.Tag) (*entity.Tag, error)) *MockUseCase_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) CreateWebhook(_a0 context.Context, _a1 entity.Webhook) (*entity.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *entity.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Webhook) (*entity.Webhook, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Webhook) *entity.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Webhook) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockUseCase_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.Webhook
+func (_e *MockUseCase_Expecter) CreateWebhook(_a0 interface{}, _a1 interface{}) *MockUseCase_CreateWebhook_Call {
+	return &MockUseCase_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", _a0, _a1)}
+}
+
+func (_c *MockUseCase_CreateWebhook_Call) Run(run func(_a0 context.Context, _a1 entity.Webhook)) *MockUseCase_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_CreateWebhook_Call) Return(_a0 *entity.Webhook, _a1 error) *MockUseCase_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_CreateWebhook_Call) RunAndReturn(run func(context.Context, entity.Webhook) (*entity.Webhook, error)) *MockUseCase_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAttachment provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) DeleteAttachment(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockUseCase_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+//   - _a4 int64
+func (_e *MockUseCase_Expecter) DeleteAttachment(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_DeleteAttachment_Call {
+	return &MockUseCase_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_DeleteAttachment_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64)) *MockUseCase_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteAttachment_Call) Return(_a0 error) *MockUseCase_DeleteAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteAttachment_Call) RunAndReturn(run func(context.Context, int64, int64, int64, int64) error) *MockUseCase_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCall provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) DeleteCall(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCall")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCall'
+type MockUseCase_DeleteCall_Call struct {
+	*mock.Call
+}
+
+// DeleteCall is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) DeleteCall(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_DeleteCall_Call {
+	return &MockUseCase_DeleteCall_Call{Call: _e.mock.On("DeleteCall", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_DeleteCall_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_DeleteCall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteCall_Call) Return(_a0 error) *MockUseCase_DeleteCall_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteCall_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockUseCase_DeleteCall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) DeleteComment(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockUseCase_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+//   - _a4 int64
+func (_e *MockUseCase_Expecter) DeleteComment(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_DeleteComment_Call {
+	return &MockUseCase_DeleteComment_Call{Call: _e.mock.On("DeleteComment", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_DeleteComment_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64)) *MockUseCase_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteComment_Call) Return(_a0 error) *MockUseCase_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteComment_Call) RunAndReturn(run func(context.Context, int64, int64, int64, int64) error) *MockUseCase_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInvitation provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) DeleteInvitation(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvitation'
+type MockUseCase_DeleteInvitation_Call struct {
+	*mock.Call
+}
+
+// DeleteInvitation is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) DeleteInvitation(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_DeleteInvitation_Call {
+	return &MockUseCase_DeleteInvitation_Call{Call: _e.mock.On("DeleteInvitation", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_DeleteInvitation_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_DeleteInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteInvitation_Call) Return(_a0 error) *MockUseCase_DeleteInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteInvitation_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockUseCase_DeleteInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) DeleteTag(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockUseCase_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) DeleteTag(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_DeleteTag_Call {
+	return &MockUseCase_DeleteTag_Call{Call: _e.mock.On("DeleteTag", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_DeleteTag_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteTag_Call) Return(_a0 error) *MockUseCase_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteTag_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockUseCase_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) DeleteWebhook(_a0 context.Context, _a1 int64, _a2 int64) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockUseCase_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) DeleteWebhook(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_DeleteWebhook_Call {
+	return &MockUseCase_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_DeleteWebhook_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DeleteWebhook_Call) Return(_a0 error) *MockUseCase_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_DeleteWebhook_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockUseCase_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachTag provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) DetachTag(_a0 context.Context, _a1 entity.TagChange) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachTag")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TagChange) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TagChange) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.TagChange) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_DetachTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachTag'
+type MockUseCase_DetachTag_Call struct {
+	*mock.Call
+}
+
+// DetachTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.TagChange
+func (_e *MockUseCase_Expecter) DetachTag(_a0 interface{}, _a1 interface{}) *MockUseCase_DetachTag_Call {
+	return &MockUseCase_DetachTag_Call{Call: _e.mock.On("DetachTag", _a0, _a1)}
+}
+
+func (_c *MockUseCase_DetachTag_Call) Run(run func(_a0 context.Context, _a1 entity.TagChange)) *MockUseCase_DetachTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.TagChange))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_DetachTag_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_DetachTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_DetachTag_Call) RunAndReturn(run func(context.Context, entity.TagChange) (*entity.CallResponse, error)) *MockUseCase_DetachTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportCalls provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) ExportCalls(_a0 context.Context, _a1 entity.CallsQuery, _a2 func(entity.CallResponse) error) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCalls")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsQuery, func(entity.CallResponse) error) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_ExportCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCalls'
+type MockUseCase_ExportCalls_Call struct {
+	*mock.Call
+}
+
+// ExportCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CallsQuery
+//   - _a2 func(entity.CallResponse) error
+func (_e *MockUseCase_Expecter) ExportCalls(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_ExportCalls_Call {
+	return &MockUseCase_ExportCalls_Call{Call: _e.mock.On("ExportCalls", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_ExportCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallsQuery, _a2 func(entity.CallResponse) error)) *MockUseCase_ExportCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CallsQuery), args[2].(func(entity.CallResponse) error))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ExportCalls_Call) Return(_a0 error) *MockUseCase_ExportCalls_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_ExportCalls_Call) RunAndReturn(run func(context.Context, entity.CallsQuery, func(entity.CallResponse) error) error) *MockUseCase_ExportCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachment provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) GetAttachment(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64) (*entity.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *entity.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) (*entity.Attachment, io.ReadCloser, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, int64) *entity.Attachment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64, int64) io.ReadCloser); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int64, int64) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUseCase_GetAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachment'
+type MockUseCase_GetAttachment_Call struct {
+	*mock.Call
+}
+
+// GetAttachment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+//   - _a4 int64
+func (_e *MockUseCase_Expecter) GetAttachment(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_GetAttachment_Call {
+	return &MockUseCase_GetAttachment_Call{Call: _e.mock.On("GetAttachment", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_GetAttachment_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 int64)) *MockUseCase_GetAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetAttachment_Call) Return(_a0 *entity.Attachment, _a1 io.ReadCloser, _a2 error) *MockUseCase_GetAttachment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUseCase_GetAttachment_Call) RunAndReturn(run func(context.Context, int64, int64, int64, int64) (*entity.Attachment, io.ReadCloser, error)) *MockUseCase_GetAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachments provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetAttachments(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) ([]entity.Attachment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachments")
+	}
+
+	var r0 []entity.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]entity.Attachment, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []entity.Attachment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachments'
+type MockUseCase_GetAttachments_Call struct {
+	*mock.Call
+}
+
+// GetAttachments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) GetAttachments(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetAttachments_Call {
+	return &MockUseCase_GetAttachments_Call{Call: _e.mock.On("GetAttachments", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetAttachments_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_GetAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetAttachments_Call) Return(_a0 []entity.Attachment, _a1 error) *MockUseCase_GetAttachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetAttachments_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]entity.Attachment, error)) *MockUseCase_GetAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCallHistory provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetCallHistory(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) ([]entity.CallEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCallHistory")
+	}
+
+	var r0 []entity.CallEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) ([]entity.CallEvent, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) []entity.CallEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CallEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetCallHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCallHistory'
+type MockUseCase_GetCallHistory_Call struct {
+	*mock.Call
+}
+
+// GetCallHistory is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) GetCallHistory(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetCallHistory_Call {
+	return &MockUseCase_GetCallHistory_Call{Call: _e.mock.On("GetCallHistory", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetCallHistory_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_GetCallHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetCallHistory_Call) Return(_a0 []entity.CallEvent, _a1 error) *MockUseCase_GetCallHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetCallHistory_Call) RunAndReturn(run func(context.Context, int64, int64, int64) ([]entity.CallEvent, error)) *MockUseCase_GetCallHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCallStats provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetCallStats(_a0 context.Context, _a1 entity.StatsQuery) (*entity.CallStats, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCallStats")
+	}
+
+	var r0 *entity.CallStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsQuery) (*entity.CallStats, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsQuery) *entity.CallStats); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetCallStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCallStats'
+type MockUseCase_GetCallStats_Call struct {
+	*mock.Call
+}
+
+// GetCallStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.StatsQuery
+func (_e *MockUseCase_Expecter) GetCallStats(_a0 interface{}, _a1 interface{}) *MockUseCase_GetCallStats_Call {
+	return &MockUseCase_GetCallStats_Call{Call: _e.mock.On("GetCallStats", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetCallStats_Call) Run(run func(_a0 context.Context, _a1 entity.StatsQuery)) *MockUseCase_GetCallStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.StatsQuery))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetCallStats_Call) Return(_a0 *entity.CallStats, _a1 error) *MockUseCase_GetCallStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetCallStats_Call) RunAndReturn(run func(context.Context, entity.StatsQuery) (*entity.CallStats, error)) *MockUseCase_GetCallStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCallsComments provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetCallsComments(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64) (map[int64][]entity.Comment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCallsComments")
+	}
+
+	var r0 map[int64][]entity.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) (map[int64][]entity.Comment, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) map[int64][]entity.Comment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]entity.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetCallsComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCallsComments'
+type MockUseCase_GetCallsComments_Call struct {
+	*mock.Call
+}
+
+// GetCallsComments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 []int64
+func (_e *MockUseCase_Expecter) GetCallsComments(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetCallsComments_Call {
+	return &MockUseCase_GetCallsComments_Call{Call: _e.mock.On("GetCallsComments", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetCallsComments_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64)) *MockUseCase_GetCallsComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetCallsComments_Call) Return(_a0 map[int64][]entity.Comment, _a1 error) *MockUseCase_GetCallsComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetCallsComments_Call) RunAndReturn(run func(context.Context, int64, int64, []int64) (map[int64][]entity.Comment, error)) *MockUseCase_GetCallsComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClient provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetClient(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.Client, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClient")
+	}
+
+	var r0 *entity.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.Client, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.Client); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Client)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClient'
+type MockUseCase_GetClient_Call struct {
+	*mock.Call
+}
+
+// GetClient is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) GetClient(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetClient_Call {
+	return &MockUseCase_GetClient_Call{Call: _e.mock.On("GetClient", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetClient_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_GetClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetClient_Call) Return(_a0 *entity.Client, _a1 error) *MockUseCase_GetClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetClient_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.Client, error)) *MockUseCase_GetClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClientCalls provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetClientCalls(_a0 context.Context, _a1 entity.CallsQuery) (*entity.CallsPage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientCalls")
+	}
+
+	var r0 *entity.CallsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsQuery) (*entity.CallsPage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsQuery) *entity.CallsPage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallsQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetClientCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientCalls'
+type MockUseCase_GetClientCalls_Call struct {
+	*mock.Call
+}
+
+// GetClientCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CallsQuery
+func (_e *MockUseCase_Expecter) GetClientCalls(_a0 interface{}, _a1 interface{}) *MockUseCase_GetClientCalls_Call {
+	return &MockUseCase_GetClientCalls_Call{Call: _e.mock.On("GetClientCalls", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetClientCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallsQuery)) *MockUseCase_GetClientCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CallsQuery))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetClientCalls_Call) Return(_a0 *entity.CallsPage, _a1 error) *MockUseCase_GetClientCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetClientCalls_Call) RunAndReturn(run func(context.Context, entity.CallsQuery) (*entity.CallsPage, error)) *MockUseCase_GetClientCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClients provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetClients(_a0 context.Context, _a1 entity.ClientsQuery) (*entity.ClientsPage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClients")
+	}
+
+	var r0 *entity.ClientsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ClientsQuery) (*entity.ClientsPage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ClientsQuery) *entity.ClientsPage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ClientsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ClientsQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClients'
+type MockUseCase_GetClients_Call struct {
+	*mock.Call
+}
+
+// GetClients is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.ClientsQuery
+func (_e *MockUseCase_Expecter) GetClients(_a0 interface{}, _a1 interface{}) *MockUseCase_GetClients_Call {
+	return &MockUseCase_GetClients_Call{Call: _e.mock.On("GetClients", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetClients_Call) Run(run func(_a0 context.Context, _a1 entity.ClientsQuery)) *MockUseCase_GetClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ClientsQuery))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetClients_Call) Return(_a0 *entity.ClientsPage, _a1 error) *MockUseCase_GetClients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetClients_Call) RunAndReturn(run func(context.Context, entity.ClientsQuery) (*entity.ClientsPage, error)) *MockUseCase_GetClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClientsByIDs provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetClientsByIDs(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64) (map[int64]entity.Client, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientsByIDs")
+	}
+
+	var r0 map[int64]entity.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) (map[int64]entity.Client, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) map[int64]entity.Client); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]entity.Client)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetClientsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientsByIDs'
+type MockUseCase_GetClientsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetClientsByIDs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 []int64
+func (_e *MockUseCase_Expecter) GetClientsByIDs(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetClientsByIDs_Call {
+	return &MockUseCase_GetClientsByIDs_Call{Call: _e.mock.On("GetClientsByIDs", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetClientsByIDs_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 []int64)) *MockUseCase_GetClientsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetClientsByIDs_Call) Return(_a0 map[int64]entity.Client, _a1 error) *MockUseCase_GetClientsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetClientsByIDs_Call) RunAndReturn(run func(context.Context, int64, int64, []int64) (map[int64]entity.Client, error)) *MockUseCase_GetClientsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComments provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) GetComments(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 *bool) ([]entity.Comment, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 []entity.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, *bool) ([]entity.Comment, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, *bool) []entity.Comment); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64, *bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComments'
+type MockUseCase_GetComments_Call struct {
+	*mock.Call
+}
+
+// GetComments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+//   - _a4 *bool
+func (_e *MockUseCase_Expecter) GetComments(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_GetComments_Call {
+	return &MockUseCase_GetComments_Call{Call: _e.mock.On("GetComments", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_GetComments_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 *bool)) *MockUseCase_GetComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(*bool))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetComments_Call) Return(_a0 []entity.Comment, _a1 error) *MockUseCase_GetComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetComments_Call) RunAndReturn(run func(context.Context, int64, int64, int64, *bool) ([]entity.Comment, error)) *MockUseCase_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitations provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetInvitations(_a0 context.Context, _a1 int64) ([]entity.Invitation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitations")
+	}
+
+	var r0 []entity.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entity.Invitation, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entity.Invitation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitations'
+type MockUseCase_GetInvitations_Call struct {
+	*mock.Call
+}
+
+// GetInvitations is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+func (_e *MockUseCase_Expecter) GetInvitations(_a0 interface{}, _a1 interface{}) *MockUseCase_GetInvitations_Call {
+	return &MockUseCase_GetInvitations_Call{Call: _e.mock.On("GetInvitations", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetInvitations_Call) Run(run func(_a0 context.Context, _a1 int64)) *MockUseCase_GetInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetInvitations_Call) Return(_a0 []entity.Invitation, _a1 error) *MockUseCase_GetInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetInvitations_Call) RunAndReturn(run func(context.Context, int64) ([]entity.Invitation, error)) *MockUseCase_GetInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrgMembers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) GetOrgMembers(_a0 context.Context, _a1 int64, _a2 int64) ([]entity.Member, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrgMembers")
+	}
+
+	var r0 []entity.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]entity.Member, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []entity.Member); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetOrgMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrgMembers'
+type MockUseCase_GetOrgMembers_Call struct {
+	*mock.Call
+}
+
+// GetOrgMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) GetOrgMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_GetOrgMembers_Call {
+	return &MockUseCase_GetOrgMembers_Call{Call: _e.mock.On("GetOrgMembers", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_GetOrgMembers_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_GetOrgMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetOrgMembers_Call) Return(_a0 []entity.Member, _a1 error) *MockUseCase_GetOrgMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetOrgMembers_Call) RunAndReturn(run func(context.Context, int64, int64) ([]entity.Member, error)) *MockUseCase_GetOrgMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrgs provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) GetOrgs(_a0 context.Context, _a1 int64, _a2 int64) ([]entity.Organization, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrgs")
+	}
+
+	var r0 []entity.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]entity.Organization, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []entity.Organization); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetOrgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrgs'
+type MockUseCase_GetOrgs_Call struct {
+	*mock.Call
+}
+
+// GetOrgs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) GetOrgs(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_GetOrgs_Call {
+	return &MockUseCase_GetOrgs_Call{Call: _e.mock.On("GetOrgs", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_GetOrgs_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_GetOrgs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetOrgs_Call) Return(_a0 []entity.Organization, _a1 error) *MockUseCase_GetOrgs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetOrgs_Call) RunAndReturn(run func(context.Context, int64, int64) ([]entity.Organization, error)) *MockUseCase_GetOrgs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetTags(_a0 context.Context, _a1 int64) ([]entity.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entity.Tag, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entity.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockUseCase_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+func (_e *MockUseCase_Expecter) GetTags(_a0 interface{}, _a1 interface{}) *MockUseCase_GetTags_Call {
+	return &MockUseCase_GetTags_Call{Call: _e.mock.On("GetTags", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetTags_Call) Run(run func(_a0 context.Context, _a1 int64)) *MockUseCase_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetTags_Call) Return(_a0 []entity.Tag, _a1 error) *MockUseCase_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetTags_Call) RunAndReturn(run func(context.Context, int64) ([]entity.Tag, error)) *MockUseCase_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpcomingCallbacks provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) GetUpcomingCallbacks(_a0 context.Context, _a1 int64, _a2 int64, _a3 time.Duration, _a4 int) ([]entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpcomingCallbacks")
+	}
+
+	var r0 []entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Duration, int) ([]entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Duration, int) []entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Duration, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetUpcomingCallbacks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpcomingCallbacks'
+type MockUseCase_GetUpcomingCallbacks_Call struct {
+	*mock.Call
+}
+
+// GetUpcomingCallbacks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 time.Duration
+//   - _a4 int
+func (_e *MockUseCase_Expecter) GetUpcomingCallbacks(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_GetUpcomingCallbacks_Call {
+	return &MockUseCase_GetUpcomingCallbacks_Call{Call: _e.mock.On("GetUpcomingCallbacks", _a0, _a1, _a2, _a3, _a4)}
+}
+
+func (_c *MockUseCase_GetUpcomingCallbacks_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 time.Duration, _a4 int)) *MockUseCase_GetUpcomingCallbacks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(time.Duration), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetUpcomingCallbacks_Call) Return(_a0 []entity.CallResponse, _a1 error) *MockUseCase_GetUpcomingCallbacks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetUpcomingCallbacks_Call) RunAndReturn(run func(context.Context, int64, int64, time.Duration, int) ([]entity.CallResponse, error)) *MockUseCase_GetUpcomingCallbacks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetUser(_a0 context.Context, _a1 int64) (*entity.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*entity.User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *entity.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockUseCase_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+func (_e *MockUseCase_Expecter) GetUser(_a0 interface{}, _a1 interface{}) *MockUseCase_GetUser_Call {
+	return &MockUseCase_GetUser_Call{Call: _e.mock.On("GetUser", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetUser_Call) Run(run func(_a0 context.Context, _a1 int64)) *MockUseCase_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetUser_Call) Return(_a0 *entity.User, _a1 error) *MockUseCase_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetUser_Call) RunAndReturn(run func(context.Context, int64) (*entity.User, error)) *MockUseCase_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCallByID provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) GetUserCallByID(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCallByID")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetUserCallByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCallByID'
+type MockUseCase_GetUserCallByID_Call struct {
+	*mock.Call
+}
+
+// GetUserCallByID is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) GetUserCallByID(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_GetUserCallByID_Call {
+	return &MockUseCase_GetUserCallByID_Call{Call: _e.mock.On("GetUserCallByID", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_GetUserCallByID_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_GetUserCallByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetUserCallByID_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_GetUserCallByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetUserCallByID_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.CallResponse, error)) *MockUseCase_GetUserCallByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCalls provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetUserCalls(_a0 context.Context, _a1 entity.CallsQuery) (*entity.CallsPage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCalls")
+	}
+
+	var r0 *entity.CallsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsQuery) (*entity.CallsPage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsQuery) *entity.CallsPage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallsQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetUserCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCalls'
+type MockUseCase_GetUserCalls_Call struct {
+	*mock.Call
+}
+
+// GetUserCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CallsQuery
+func (_e *MockUseCase_Expecter) GetUserCalls(_a0 interface{}, _a1 interface{}) *MockUseCase_GetUserCalls_Call {
+	return &MockUseCase_GetUserCalls_Call{Call: _e.mock.On("GetUserCalls", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetUserCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallsQuery)) *MockUseCase_GetUserCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CallsQuery))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetUserCalls_Call) Return(_a0 *entity.CallsPage, _a1 error) *MockUseCase_GetUserCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetUserCalls_Call) RunAndReturn(run func(context.Context, entity.CallsQuery) (*entity.CallsPage, error)) *MockUseCase_GetUserCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetWebhookDeliveries(_a0 context.Context, _a1 entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WebhookDeliveriesQuery) []entity.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.WebhookDeliveriesQuery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockUseCase_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.WebhookDeliveriesQuery
+func (_e *MockUseCase_Expecter) GetWebhookDeliveries(_a0 interface{}, _a1 interface{}) *MockUseCase_GetWebhookDeliveries_Call {
+	return &MockUseCase_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetWebhookDeliveries_Call) Run(run func(_a0 context.Context, _a1 entity.WebhookDeliveriesQuery)) *MockUseCase_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.WebhookDeliveriesQuery))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetWebhookDeliveries_Call) Return(_a0 []entity.WebhookDelivery, _a1 error) *MockUseCase_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error)) *MockUseCase_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) GetWebhooks(_a0 context.Context, _a1 int64) ([]entity.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []entity.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entity.Webhook, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []entity.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockUseCase_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+func (_e *MockUseCase_Expecter) GetWebhooks(_a0 interface{}, _a1 interface{}) *MockUseCase_GetWebhooks_Call {
+	return &MockUseCase_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", _a0, _a1)}
+}
+
+func (_c *MockUseCase_GetWebhooks_Call) Run(run func(_a0 context.Context, _a1 int64)) *MockUseCase_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetWebhooks_Call) Return(_a0 []entity.Webhook, _a1 error) *MockUseCase_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_GetWebhooks_Call) RunAndReturn(run func(context.Context, int64) ([]entity.Webhook, error)) *MockUseCase_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportCalls provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) ImportCalls(_a0 context.Context, _a1 entity.CallSource, _a2 bool, _a3 bool) (*entity.ImportResult, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCalls")
+	}
+
+	var r0 *entity.ImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallSource, bool, bool) (*entity.ImportResult, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallSource, bool, bool) *entity.ImportResult); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ImportResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallSource, bool, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_ImportCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportCalls'
+type MockUseCase_ImportCalls_Call struct {
+	*mock.Call
+}
+
+// ImportCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CallSource
+//   - _a2 bool
+//   - _a3 bool
+func (_e *MockUseCase_Expecter) ImportCalls(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_ImportCalls_Call {
+	return &MockUseCase_ImportCalls_Call{Call: _e.mock.On("ImportCalls", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_ImportCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallSource, _a2 bool, _a3 bool)) *MockUseCase_ImportCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CallSource), args[2].(bool), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ImportCalls_Call) Return(_a0 *entity.ImportResult, _a1 error) *MockUseCase_ImportCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_ImportCalls_Call) RunAndReturn(run func(context.Context, entity.CallSource, bool, bool) (*entity.ImportResult, error)) *MockUseCase_ImportCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InviteToOrg provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) InviteToOrg(_a0 context.Context, _a1 entity.Invitation) (*entity.Invitation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteToOrg")
+	}
+
+	var r0 *entity.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Invitation) (*entity.Invitation, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Invitation) *entity.Invitation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Invitation) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_InviteToOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteToOrg'
+type MockUseCase_InviteToOrg_Call struct {
+	*mock.Call
+}
+
+// InviteToOrg is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.Invitation
+func (_e *MockUseCase_Expecter) InviteToOrg(_a0 interface{}, _a1 interface{}) *MockUseCase_InviteToOrg_Call {
+	return &MockUseCase_InviteToOrg_Call{Call: _e.mock.On("InviteToOrg", _a0, _a1)}
+}
+
+func (_c *MockUseCase_InviteToOrg_Call) Run(run func(_a0 context.Context, _a1 entity.Invitation)) *MockUseCase_InviteToOrg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Invitation))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_InviteToOrg_Call) Return(_a0 *entity.Invitation, _a1 error) *MockUseCase_InviteToOrg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_InviteToOrg_Call) RunAndReturn(run func(context.Context, entity.Invitation) (*entity.Invitation, error)) *MockUseCase_InviteToOrg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUser provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) LoginUser(_a0 context.Context, _a1 entity.AuthRequest) (string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginUser")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) (string, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.AuthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
+type MockUseCase_LoginUser_Call struct {
+	*mock.Call
+}
+
+// LoginUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.AuthRequest
+func (_e *MockUseCase_Expecter) LoginUser(_a0 interface{}, _a1 interface{}) *MockUseCase_LoginUser_Call {
+	return &MockUseCase_LoginUser_Call{Call: _e.mock.On("LoginUser", _a0, _a1)}
+}
+
+func (_c *MockUseCase_LoginUser_Call) Run(run func(_a0 context.Context, _a1 entity.AuthRequest)) *MockUseCase_LoginUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.AuthRequest))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_LoginUser_Call) Return(_a0 string, _a1 error) *MockUseCase_LoginUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_LoginUser_Call) RunAndReturn(run func(context.Context, entity.AuthRequest) (string, error)) *MockUseCase_LoginUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCalls provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) MergeCalls(_a0 context.Context, _a1 entity.CallMerge) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCalls")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallMerge) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallMerge) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallMerge) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_MergeCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCalls'
+type MockUseCase_MergeCalls_Call struct {
+	*mock.Call
+}
+
+// MergeCalls is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CallMerge
+func (_e *MockUseCase_Expecter) MergeCalls(_a0 interface{}, _a1 interface{}) *MockUseCase_MergeCalls_Call {
+	return &MockUseCase_MergeCalls_Call{Call: _e.mock.On("MergeCalls", _a0, _a1)}
+}
+
+func (_c *MockUseCase_MergeCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallMerge)) *MockUseCase_MergeCalls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CallMerge))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_MergeCalls_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_MergeCalls_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_MergeCalls_Call) RunAndReturn(run func(context.Context, entity.CallMerge) (*entity.CallResponse, error)) *MockUseCase_MergeCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeliverWebhook provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) RedeliverWebhook(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeliverWebhook")
+	}
+
+	var r0 *entity.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_RedeliverWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeliverWebhook'
+type MockUseCase_RedeliverWebhook_Call struct {
+	*mock.Call
+}
+
+// RedeliverWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) RedeliverWebhook(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_RedeliverWebhook_Call {
+	return &MockUseCase_RedeliverWebhook_Call{Call: _e.mock.On("RedeliverWebhook", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_RedeliverWebhook_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_RedeliverWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RedeliverWebhook_Call) Return(_a0 *entity.WebhookDelivery, _a1 error) *MockUseCase_RedeliverWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_RedeliverWebhook_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.WebhookDelivery, error)) *MockUseCase_RedeliverWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterUser provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) RegisterUser(_a0 context.Context, _a1 entity.AuthRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
+type MockUseCase_RegisterUser_Call struct {
+	*mock.Call
+}
+
+// RegisterUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.AuthRequest
+func (_e *MockUseCase_Expecter) RegisterUser(_a0 interface{}, _a1 interface{}) *MockUseCase_RegisterUser_Call {
+	return &MockUseCase_RegisterUser_Call{Call: _e.mock.On("RegisterUser", _a0, _a1)}
+}
+
+func (_c *MockUseCase_RegisterUser_Call) Run(run func(_a0 context.Context, _a1 entity.AuthRequest)) *MockUseCase_RegisterUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.AuthRequest))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RegisterUser_Call) Return(_a0 error) *MockUseCase_RegisterUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_RegisterUser_Call) RunAndReturn(run func(context.Context, entity.AuthRequest) error) *MockUseCase_RegisterUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) RemoveMember(_a0 context.Context, _a1 entity.MemberRemoval) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.MemberRemoval) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUseCase_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockUseCase_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.MemberRemoval
+func (_e *MockUseCase_Expecter) RemoveMember(_a0 interface{}, _a1 interface{}) *MockUseCase_RemoveMember_Call {
+	return &MockUseCase_RemoveMember_Call{Call: _e.mock.On("RemoveMember", _a0, _a1)}
+}
+
+func (_c *MockUseCase_RemoveMember_Call) Run(run func(_a0 context.Context, _a1 entity.MemberRemoval)) *MockUseCase_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.MemberRemoval))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RemoveMember_Call) Return(_a0 error) *MockUseCase_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUseCase_RemoveMember_Call) RunAndReturn(run func(context.Context, entity.MemberRemoval) error) *MockUseCase_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) RenameTag(_a0 context.Context, _a1 entity.Tag) (*entity.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Tag) (*entity.Tag, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Tag) *entity.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Tag) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockUseCase_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.Tag
+func (_e *MockUseCase_Expecter) RenameTag(_a0 interface{}, _a1 interface{}) *MockUseCase_RenameTag_Call {
+	return &MockUseCase_RenameTag_Call{Call: _e.mock.On("RenameTag", _a0, _a1)}
+}
+
+func (_c *MockUseCase_RenameTag_Call) Run(run func(_a0 context.Context, _a1 entity.Tag)) *MockUseCase_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Tag))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RenameTag_Call) Return(_a0 *entity.Tag, _a1 error) *MockUseCase_RenameTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_RenameTag_Call) RunAndReturn(run func(context.Context, entity.Tag) (*entity.Tag, error)) *MockUseCase_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreCall provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) RestoreCall(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCall")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_RestoreCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreCall'
+type MockUseCase_RestoreCall_Call struct {
+	*mock.Call
+}
+
+// RestoreCall is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) RestoreCall(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_RestoreCall_Call {
+	return &MockUseCase_RestoreCall_Call{Call: _e.mock.On("RestoreCall", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_RestoreCall_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_RestoreCall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_RestoreCall_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_RestoreCall_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_RestoreCall_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.CallResponse, error)) *MockUseCase_RestoreCall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCall provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) SaveCall(_a0 context.Context, _a1 entity.Call, _a2 bool) (int64, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCall")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Call, bool) (int64, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Call, bool) int64); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Call, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockUseCase_DeleteCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCall'
-type MockUseCase_DeleteCall_Call struct {
+// MockUseCase_SaveCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCall'
+type MockUseCase_SaveCall_Call struct {
 	*mock.Call
 }
 
-// DeleteCall is a helper method to define mock.On call
+// SaveCall is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 int64
-//   - _a2 int64
-func (_e *MockUseCase_Expecter) DeleteCall(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_DeleteCall_Call {
-	return &MockUseCase_DeleteCall_Call{Call: _e.mock.On("DeleteCall", _a0, _a1, _a2)}
+//   - _a1 entity.Call
+//   - _a2 bool
+func (_e *MockUseCase_Expecter) SaveCall(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_SaveCall_Call {
+	return &MockUseCase_SaveCall_Call{Call: _e.mock.On("SaveCall", _a0, _a1, _a2)}
 }
 
-func (_c *MockUseCase_DeleteCall_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_DeleteCall_Call {
+func (_c *MockUseCase_SaveCall_Call) Run(run func(_a0 context.Context, _a1 entity.Call, _a2 bool)) *MockUseCase_SaveCall_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+		run(args[0].(context.Context), args[1].(entity.Call), args[2].(bool))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_DeleteCall_Call) Return(_a0 error) *MockUseCase_DeleteCall_Call {
-	_c.Call.Return(_a0)
+func (_c *MockUseCase_SaveCall_Call) Return(_a0 int64, _a1 error) *MockUseCase_SaveCall_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_DeleteCall_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockUseCase_DeleteCall_Call {
+func (_c *MockUseCase_SaveCall_Call) RunAndReturn(run func(context.Context, entity.Call, bool) (int64, error)) *MockUseCase_SaveCall_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserCallByID provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockUseCase) GetUserCallByID(_a0 context.Context, _a1 int64, _a2 int64) (*entity.CallResponse, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// ScheduleCallback provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) ScheduleCallback(_a0 context.Context, _a1 entity.Callback) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserCallByID")
+		panic("no return value specified for ScheduleCallback")
 	}
 
 	var r0 *entity.CallResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (*entity.CallResponse, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Callback) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) *entity.CallResponse); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Callback) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.CallResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Callback) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,58 +2962,57 @@ func (_m *MockUseCase) GetUserCallByID(_a0 context.Context, _a1 int64, _a2 int64
 	return r0, r1
 }
 
-// MockUseCase_GetUserCallByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCallByID'
-type MockUseCase_GetUserCallByID_Call struct {
+// MockUseCase_ScheduleCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleCallback'
+type MockUseCase_ScheduleCallback_Call struct {
 	*mock.Call
 }
 
-// GetUserCallByID is a helper method to define mock.On call
+// ScheduleCallback is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 int64
-//   - _a2 int64
-func (_e *MockUseCase_Expecter) GetUserCallByID(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_GetUserCallByID_Call {
-	return &MockUseCase_GetUserCallByID_Call{Call: _e.mock.On("GetUserCallByID", _a0, _a1, _a2)}
+//   - _a1 entity.Callback
+func (_e *MockUseCase_Expecter) ScheduleCallback(_a0 interface{}, _a1 interface{}) *MockUseCase_ScheduleCallback_Call {
+	return &MockUseCase_ScheduleCallback_Call{Call: _e.mock.On("ScheduleCallback", _a0, _a1)}
 }
 
-func (_c *MockUseCase_GetUserCallByID_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_GetUserCallByID_Call {
+func (_c *MockUseCase_ScheduleCallback_Call) Run(run func(_a0 context.Context, _a1 entity.Callback)) *MockUseCase_ScheduleCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+		run(args[0].(context.Context), args[1].(entity.Callback))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_GetUserCallByID_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_GetUserCallByID_Call {
+func (_c *MockUseCase_ScheduleCallback_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_ScheduleCallback_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_GetUserCallByID_Call) RunAndReturn(run func(context.Context, int64, int64) (*entity.CallResponse, error)) *MockUseCase_GetUserCallByID_Call {
+func (_c *MockUseCase_ScheduleCallback_Call) RunAndReturn(run func(context.Context, entity.Callback) (*entity.CallResponse, error)) *MockUseCase_ScheduleCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserCalls provides a mock function with given fields: _a0, _a1
-func (_m *MockUseCase) GetUserCalls(_a0 context.Context, _a1 int64) ([]entity.CallResponse, error) {
+// SearchCalls provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) SearchCalls(_a0 context.Context, _a1 entity.CallsSearchQuery) ([]entity.CallSearchResult, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserCalls")
+		panic("no return value specified for SearchCalls")
 	}
 
-	var r0 []entity.CallResponse
+	var r0 []entity.CallSearchResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]entity.CallResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsSearchQuery) ([]entity.CallSearchResult, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []entity.CallResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallsSearchQuery) []entity.CallSearchResult); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.CallResponse)
+			r0 = ret.Get(0).([]entity.CallSearchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallsSearchQuery) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -160,55 +3021,57 @@ func (_m *MockUseCase) GetUserCalls(_a0 context.Context, _a1 int64) ([]entity.Ca
 	return r0, r1
 }
 
-// MockUseCase_GetUserCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCalls'
-type MockUseCase_GetUserCalls_Call struct {
+// MockUseCase_SearchCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCalls'
+type MockUseCase_SearchCalls_Call struct {
 	*mock.Call
 }
 
-// GetUserCalls is a helper method to define mock.On call
+// SearchCalls is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 int64
-func (_e *MockUseCase_Expecter) GetUserCalls(_a0 interface{}, _a1 interface{}) *MockUseCase_GetUserCalls_Call {
-	return &MockUseCase_GetUserCalls_Call{Call: _e.mock.On("GetUserCalls", _a0, _a1)}
+//   - _a1 entity.CallsSearchQuery
+func (_e *MockUseCase_Expecter) SearchCalls(_a0 interface{}, _a1 interface{}) *MockUseCase_SearchCalls_Call {
+	return &MockUseCase_SearchCalls_Call{Call: _e.mock.On("SearchCalls", _a0, _a1)}
 }
 
-func (_c *MockUseCase_GetUserCalls_Call) Run(run func(_a0 context.Context, _a1 int64)) *MockUseCase_GetUserCalls_Call {
+func (_c *MockUseCase_SearchCalls_Call) Run(run func(_a0 context.Context, _a1 entity.CallsSearchQuery)) *MockUseCase_SearchCalls_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(entity.CallsSearchQuery))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_GetUserCalls_Call) Return(_a0 []entity.CallResponse, _a1 error) *MockUseCase_GetUserCalls_Call {
+func (_c *MockUseCase_SearchCalls_Call) Return(_a0 []entity.CallSearchResult, _a1 error) *MockUseCase_SearchCalls_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_GetUserCalls_Call) RunAndReturn(run func(context.Context, int64) ([]entity.CallResponse, error)) *MockUseCase_GetUserCalls_Call {
+func (_c *MockUseCase_SearchCalls_Call) RunAndReturn(run func(context.Context, entity.CallsSearchQuery) ([]entity.CallSearchResult, error)) *MockUseCase_SearchCalls_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoginUser provides a mock function with given fields: _a0, _a1
-func (_m *MockUseCase) LoginUser(_a0 context.Context, _a1 entity.AuthRequest) (string, error) {
+// SnoozeCallback provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) SnoozeCallback(_a0 context.Context, _a1 entity.CallbackSnooze) (*entity.CallResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LoginUser")
+		panic("no return value specified for SnoozeCallback")
 	}
 
-	var r0 string
+	var r0 *entity.CallResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallbackSnooze) (*entity.CallResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallbackSnooze) *entity.CallResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entity.AuthRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallbackSnooze) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -217,140 +3080,274 @@ func (_m *MockUseCase) LoginUser(_a0 context.Context, _a1 entity.AuthRequest) (s
 	return r0, r1
 }
 
-// MockUseCase_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
-type MockUseCase_LoginUser_Call struct {
+// MockUseCase_SnoozeCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnoozeCallback'
+type MockUseCase_SnoozeCallback_Call struct {
 	*mock.Call
 }
 
-// LoginUser is a helper method to define mock.On call
+// SnoozeCallback is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 entity.AuthRequest
-func (_e *MockUseCase_Expecter) LoginUser(_a0 interface{}, _a1 interface{}) *MockUseCase_LoginUser_Call {
-	return &MockUseCase_LoginUser_Call{Call: _e.mock.On("LoginUser", _a0, _a1)}
+//   - _a1 entity.CallbackSnooze
+func (_e *MockUseCase_Expecter) SnoozeCallback(_a0 interface{}, _a1 interface{}) *MockUseCase_SnoozeCallback_Call {
+	return &MockUseCase_SnoozeCallback_Call{Call: _e.mock.On("SnoozeCallback", _a0, _a1)}
 }
 
-func (_c *MockUseCase_LoginUser_Call) Run(run func(_a0 context.Context, _a1 entity.AuthRequest)) *MockUseCase_LoginUser_Call {
+func (_c *MockUseCase_SnoozeCallback_Call) Run(run func(_a0 context.Context, _a1 entity.CallbackSnooze)) *MockUseCase_SnoozeCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.AuthRequest))
+		run(args[0].(context.Context), args[1].(entity.CallbackSnooze))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_LoginUser_Call) Return(_a0 string, _a1 error) *MockUseCase_LoginUser_Call {
+func (_c *MockUseCase_SnoozeCallback_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_SnoozeCallback_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_LoginUser_Call) RunAndReturn(run func(context.Context, entity.AuthRequest) (string, error)) *MockUseCase_LoginUser_Call {
+func (_c *MockUseCase_SnoozeCallback_Call) RunAndReturn(run func(context.Context, entity.CallbackSnooze) (*entity.CallResponse, error)) *MockUseCase_SnoozeCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterUser provides a mock function with given fields: _a0, _a1
-func (_m *MockUseCase) RegisterUser(_a0 context.Context, _a1 entity.AuthRequest) error {
-	ret := _m.Called(_a0, _a1)
+// SubscribeCalls provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) SubscribeCalls(_a0 context.Context, _a1 int64, _a2 int64) <-chan entity.CallStreamEvent {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterUser")
+		panic("no return value specified for SubscribeCalls")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.AuthRequest) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 <-chan entity.CallStreamEvent
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) <-chan entity.CallStreamEvent); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan entity.CallStreamEvent)
+		}
 	}
 
 	return r0
 }
 
-// MockUseCase_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
-type MockUseCase_RegisterUser_Call struct {
+// MockUseCase_SubscribeCalls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeCalls'
+type MockUseCase_SubscribeCalls_Call struct {
 	*mock.Call
 }
 
-// RegisterUser is a helper method to define mock.On call
+// SubscribeCalls is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 entity.AuthRequest
-func (_e *MockUseCase_Expecter) RegisterUser(_a0 interface{}, _a1 interface{}) *MockUseCase_RegisterUser_Call {
-	return &MockUseCase_RegisterUser_Call{Call: _e.mock.On("RegisterUser", _a0, _a1)}
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) SubscribeCalls(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_SubscribeCalls_Call {
+	return &MockUseCase_SubscribeCalls_Call{Call: _e.mock.On("SubscribeCalls", _a0, _a1, _a2)}
 }
 
-func (_c *MockUseCase_RegisterUser_Call) Run(run func(_a0 context.Context, _a1 entity.AuthRequest)) *MockUseCase_RegisterUser_Call {
+func (_c *MockUseCase_SubscribeCalls_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_SubscribeCalls_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.AuthRequest))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_RegisterUser_Call) Return(_a0 error) *MockUseCase_RegisterUser_Call {
+func (_c *MockUseCase_SubscribeCalls_Call) Return(_a0 <-chan entity.CallStreamEvent) *MockUseCase_SubscribeCalls_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockUseCase_RegisterUser_Call) RunAndReturn(run func(context.Context, entity.AuthRequest) error) *MockUseCase_RegisterUser_Call {
+func (_c *MockUseCase_SubscribeCalls_Call) RunAndReturn(run func(context.Context, int64, int64) <-chan entity.CallStreamEvent) *MockUseCase_SubscribeCalls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchOrg provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUseCase) SwitchOrg(_a0 context.Context, _a1 int64, _a2 int64) (string, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwitchOrg")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (string, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) string); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_SwitchOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchOrg'
+type MockUseCase_SwitchOrg_Call struct {
+	*mock.Call
+}
+
+// SwitchOrg is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+func (_e *MockUseCase_Expecter) SwitchOrg(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUseCase_SwitchOrg_Call {
+	return &MockUseCase_SwitchOrg_Call{Call: _e.mock.On("SwitchOrg", _a0, _a1, _a2)}
+}
+
+func (_c *MockUseCase_SwitchOrg_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64)) *MockUseCase_SwitchOrg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_SwitchOrg_Call) Return(_a0 string, _a1 error) *MockUseCase_SwitchOrg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_SwitchOrg_Call) RunAndReturn(run func(context.Context, int64, int64) (string, error)) *MockUseCase_SwitchOrg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignCall provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *MockUseCase) UnassignCall(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64) (*entity.CallResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignCall")
+	}
+
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *entity.CallResponse); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_UnassignCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignCall'
+type MockUseCase_UnassignCall_Call struct {
+	*mock.Call
+}
+
+// UnassignCall is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int64
+//   - _a2 int64
+//   - _a3 int64
+func (_e *MockUseCase_Expecter) UnassignCall(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_UnassignCall_Call {
+	return &MockUseCase_UnassignCall_Call{Call: _e.mock.On("UnassignCall", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *MockUseCase_UnassignCall_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64)) *MockUseCase_UnassignCall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_UnassignCall_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_UnassignCall_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_UnassignCall_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*entity.CallResponse, error)) *MockUseCase_UnassignCall_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveCall provides a mock function with given fields: _a0, _a1
-func (_m *MockUseCase) SaveCall(_a0 context.Context, _a1 entity.Call) error {
+// UpdateCall provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) UpdateCall(_a0 context.Context, _a1 entity.CallUpdate) (*entity.CallResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveCall")
+		panic("no return value specified for UpdateCall")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Call) error); ok {
+	var r0 *entity.CallResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallUpdate) (*entity.CallResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CallUpdate) *entity.CallResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CallResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CallUpdate) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockUseCase_SaveCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCall'
-type MockUseCase_SaveCall_Call struct {
+// MockUseCase_UpdateCall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCall'
+type MockUseCase_UpdateCall_Call struct {
 	*mock.Call
 }
 
-// SaveCall is a helper method to define mock.On call
+// UpdateCall is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 entity.Call
-func (_e *MockUseCase_Expecter) SaveCall(_a0 interface{}, _a1 interface{}) *MockUseCase_SaveCall_Call {
-	return &MockUseCase_SaveCall_Call{Call: _e.mock.On("SaveCall", _a0, _a1)}
+//   - _a1 entity.CallUpdate
+func (_e *MockUseCase_Expecter) UpdateCall(_a0 interface{}, _a1 interface{}) *MockUseCase_UpdateCall_Call {
+	return &MockUseCase_UpdateCall_Call{Call: _e.mock.On("UpdateCall", _a0, _a1)}
 }
 
-func (_c *MockUseCase_SaveCall_Call) Run(run func(_a0 context.Context, _a1 entity.Call)) *MockUseCase_SaveCall_Call {
+func (_c *MockUseCase_UpdateCall_Call) Run(run func(_a0 context.Context, _a1 entity.CallUpdate)) *MockUseCase_UpdateCall_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.Call))
+		run(args[0].(context.Context), args[1].(entity.CallUpdate))
 	})
 	return _c
 }
 
-func (_c *MockUseCase_SaveCall_Call) Return(_a0 error) *MockUseCase_SaveCall_Call {
-	_c.Call.Return(_a0)
+func (_c *MockUseCase_UpdateCall_Call) Return(_a0 *entity.CallResponse, _a1 error) *MockUseCase_UpdateCall_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUseCase_SaveCall_Call) RunAndReturn(run func(context.Context, entity.Call) error) *MockUseCase_SaveCall_Call {
+func (_c *MockUseCase_UpdateCall_Call) RunAndReturn(run func(context.Context, entity.CallUpdate) (*entity.CallResponse, error)) *MockUseCase_UpdateCall_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateCallStatus provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *MockUseCase) UpdateCallStatus(_a0 context.Context, _a1 int64, _a2 int64, _a3 string) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// UpdateCallStatus provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *MockUseCase) UpdateCallStatus(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateCallStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -367,14 +3364,15 @@ type MockUseCase_UpdateCallStatus_Call struct {
 //   - _a0 context.Context
 //   - _a1 int64
 //   - _a2 int64
-//   - _a3 string
-func (_e *MockUseCase_Expecter) UpdateCallStatus(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *MockUseCase_UpdateCallStatus_Call {
-	return &MockUseCase_UpdateCallStatus_Call{Call: _e.mock.On("UpdateCallStatus", _a0, _a1, _a2, _a3)}
+//   - _a3 int64
+//   - _a4 string
+func (_e *MockUseCase_Expecter) UpdateCallStatus(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}, _a4 interface{}) *MockUseCase_UpdateCallStatus_Call {
+	return &MockUseCase_UpdateCallStatus_Call{Call: _e.mock.On("UpdateCallStatus", _a0, _a1, _a2, _a3, _a4)}
 }
 
-func (_c *MockUseCase_UpdateCallStatus_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 string)) *MockUseCase_UpdateCallStatus_Call {
+func (_c *MockUseCase_UpdateCallStatus_Call) Run(run func(_a0 context.Context, _a1 int64, _a2 int64, _a3 int64, _a4 string)) *MockUseCase_UpdateCallStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64), args[4].(string))
 	})
 	return _c
 }
@@ -384,7 +3382,66 @@ func (_c *MockUseCase_UpdateCallStatus_Call) Return(_a0 error) *MockUseCase_Upda
 	return _c
 }
 
-func (_c *MockUseCase_UpdateCallStatus_Call) RunAndReturn(run func(context.Context, int64, int64, string) error) *MockUseCase_UpdateCallStatus_Call {
+func (_c *MockUseCase_UpdateCallStatus_Call) RunAndReturn(run func(context.Context, int64, int64, int64, string) error) *MockUseCase_UpdateCallStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: _a0, _a1
+func (_m *MockUseCase) UpdateComment(_a0 context.Context, _a1 entity.CommentUpdate) (*entity.Comment, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *entity.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CommentUpdate) (*entity.Comment, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.CommentUpdate) *entity.Comment); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.CommentUpdate) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUseCase_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockUseCase_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 entity.CommentUpdate
+func (_e *MockUseCase_Expecter) UpdateComment(_a0 interface{}, _a1 interface{}) *MockUseCase_UpdateComment_Call {
+	return &MockUseCase_UpdateComment_Call{Call: _e.mock.On("UpdateComment", _a0, _a1)}
+}
+
+func (_c *MockUseCase_UpdateComment_Call) Run(run func(_a0 context.Context, _a1 entity.CommentUpdate)) *MockUseCase_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.CommentUpdate))
+	})
+	return _c
+}
+
+func (_c *MockUseCase_UpdateComment_Call) Return(_a0 *entity.Comment, _a1 error) *MockUseCase_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUseCase_UpdateComment_Call) RunAndReturn(run func(context.Context, entity.CommentUpdate) (*entity.Comment, error)) *MockUseCase_UpdateComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrNotAttachmentAuthor = errors.New("attachment belongs to another user")
+)
+
+const attachmentColumns = `id, call_id, user_id, file_name, content_type, size, storage_key, created_at`
+
+const (
+	querySaveAttachment   = `INSERT INTO call_attachments (call_id, user_id, file_name, content_type, size, storage_key) VALUES ($1, $2, $3, $4, $5, $6) RETURNING ` + attachmentColumns
+	queryGetAttachments   = `SELECT ` + attachmentColumns + ` FROM call_attachments WHERE call_id = $1 ORDER BY id`
+	queryGetAttachment    = `SELECT ` + attachmentColumns + ` FROM call_attachments WHERE id = $1 AND call_id = $2`
+	queryDeleteAttachment = `DELETE FROM call_attachments WHERE id = $1 AND call_id = $2 AND user_id = $3 RETURNING storage_key`
+	queryAttachmentExists = `SELECT EXISTS (SELECT 1 FROM call_attachments WHERE id = $1 AND call_id = $2)`
+)
+
+func scanAttachment(row pgx.Row, a *entity.Attachment) error {
+	return row.Scan(
+		&a.ID,
+		&a.CallID,
+		&a.UserID,
+		&a.FileName,
+		&a.ContentType,
+		&a.Size,
+		&a.StorageKey,
+		&a.CreatedAt,
+	)
+}
+
+func (r *CallsRepo) SaveAttachment(ctx context.Context, a entity.Attachment) (*entity.Attachment, error) {
+	var saved entity.Attachment
+
+	err := scanAttachment(r.Pool.QueryRow(ctx, querySaveAttachment,
+		a.CallID,
+		a.UserID,
+		a.FileName,
+		a.ContentType,
+		a.Size,
+		a.StorageKey,
+	), &saved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	return &saved, nil
+}
+
+func (r *CallsRepo) GetAttachments(ctx context.Context, callID int64) ([]entity.Attachment, error) {
+	rows, err := r.Pool.Query(ctx, queryGetAttachments, callID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := []entity.Attachment{}
+	for rows.Next() {
+		var a entity.Attachment
+		if err := scanAttachment(rows, &a); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *CallsRepo) GetAttachment(ctx context.Context, callID, attachmentID int64) (*entity.Attachment, error) {
+	var a entity.Attachment
+
+	if err := scanAttachment(r.Pool.QueryRow(ctx, queryGetAttachment, attachmentID, callID), &a); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return &a, nil
+}
+
+// DeleteAttachment deletes an attachment uploaded by the user and returns the
+// storage key of its file.
+func (r *CallsRepo) DeleteAttachment(ctx context.Context, callID, attachmentID, userID int64) (string, error) {
+	var key string
+
+	if err := r.Pool.QueryRow(ctx, queryDeleteAttachment, attachmentID, callID, userID).Scan(&key); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return "", r.attachmentMissError(ctx, attachmentID, callID)
+		}
+		return "", fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return key, nil
+}
+
+// attachmentMissError explains why an attachment guarded by its author was not deleted.
+func (r *CallsRepo) attachmentMissError(ctx context.Context, attachmentID, callID int64) error {
+	var exists bool
+	if err := r.Pool.QueryRow(ctx, queryAttachmentExists, attachmentID, callID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check attachment: %w", err)
+	}
+	if !exists {
+		return ErrAttachmentNotFound
+	}
+	return ErrNotAttachmentAuthor
+}
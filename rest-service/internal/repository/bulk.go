@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const queryGetUserCallsByIDs = `SELECT ` + callColumns + ` FROM calls WHERE id = ANY($1) AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND deleted_at IS NULL`
+
+// errBulkRollback rolls back an atomic bulk transaction in which an item failed.
+var errBulkRollback = errors.New("bulk operation rolled back")
+
+// GetUserCallsByIDs returns the calls from ids that are visible to the user
+// in the organization, in no particular order.
+func (r *CallsRepo) GetUserCallsByIDs(ctx context.Context, userID, orgID int64, ids []int64) ([]entity.CallResponse, error) {
+	rows, err := r.Pool.Query(ctx, queryGetUserCallsByIDs, ids, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calls: %w", err)
+	}
+	defer rows.Close()
+
+	calls := make([]entity.CallResponse, 0, len(ids))
+	for rows.Next() {
+		var call entity.CallResponse
+		if err := scanCall(rows, &call); err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return calls, nil
+}
+
+// SaveCalls inserts calls in one transaction and returns their IDs in the
+// order of calls, and an error per call that was not saved. A call with
+// DuplicateWithin set is refused with a *DuplicateCallError if its number has
+// a recent open call, including one saved earlier from calls. In atomic mode a
+// single refused call rolls every insert back, and a call repeating another
+// one from calls is refused with ErrDuplicateItem.
+func (r *CallsRepo) SaveCalls(ctx context.Context, calls []entity.Call, atomic bool) ([]int64, []error, error) {
+	saved := make([]entity.CallResponse, len(calls))
+	itemErrs := make([]error, len(calls))
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		duplicates, err := findDuplicateCalls(ctx, tx, calls)
+		if err != nil {
+			return err
+		}
+
+		// A call repeating an earlier one from calls is a duplicate of it,
+		// whose ID is only known once the batch is sent.
+		firstSaved := make(map[string]int)
+		repeats := make(map[int]int)
+		batch := &pgx.Batch{}
+		for i, call := range calls {
+			if call.DuplicateWithin > 0 {
+				if duplicates[i] != 0 {
+					itemErrs[i] = &DuplicateCallError{CallID: duplicates[i]}
+					continue
+				}
+				if j, ok := firstSaved[call.PhoneE164]; ok {
+					repeats[i] = j
+					continue
+				}
+			}
+			if _, ok := firstSaved[call.PhoneE164]; !ok {
+				firstSaved[call.PhoneE164] = i
+			}
+
+			batch.Queue(querySaveCall,
+				call.ClientName,
+				call.PhoneNumber,
+				call.Description,
+				call.UserID,
+				call.Priority,
+				call.DueIn.Seconds(),
+				call.PhoneE164,
+				call.OrgID,
+			).QueryRow(func(row pgx.Row) error {
+				return scanCall(row, &saved[i])
+			})
+		}
+		if atomic && batch.Len() < len(calls) {
+			for i := range repeats {
+				itemErrs[i] = ErrDuplicateItem
+			}
+			return errBulkRollback
+		}
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to insert calls: %w", err)
+		}
+		for i, j := range repeats {
+			itemErrs[i] = &DuplicateCallError{CallID: saved[j].ID}
+		}
+
+		var events []entity.CallEvent
+		for i := range saved {
+			if saved[i].ID != 0 {
+				events = append(events, diffEvents(entity.EventCreated, calls[i].UserID, nil, &saved[i])...)
+			}
+		}
+		return recordEvents(ctx, tx, events)
+	})
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		return nil, nil, err
+	}
+
+	ids := make([]int64, len(saved))
+	for i := range saved {
+		ids[i] = saved[i].ID
+	}
+	return ids, itemErrs, nil
+}
+
+// findDuplicateCalls locks the numbers of the calls with DuplicateWithin set,
+// in a fixed order so that concurrent batches cannot deadlock, and returns the
+// ID of the recent open call each of them repeats, or 0.
+func findDuplicateCalls(ctx context.Context, tx pgx.Tx, calls []entity.Call) ([]int64, error) {
+	duplicates := make([]int64, len(calls))
+
+	var phones []string
+	batch := &pgx.Batch{}
+	for i, call := range calls {
+		if call.DuplicateWithin == 0 {
+			continue
+		}
+		phones = append(phones, call.PhoneE164)
+		batch.Queue(queryFindDuplicateCall, call.PhoneE164, call.UserID, call.DuplicateWithin.Seconds(), call.OrgID).QueryRow(func(row pgx.Row) error {
+			if err := row.Scan(&duplicates[i]); err != nil && !postgres.IsNotFoundError(err) {
+				return err
+			}
+			return nil
+		})
+	}
+	if len(phones) == 0 {
+		return duplicates, nil
+	}
+
+	if _, err := tx.Exec(ctx, queryLockPhones, phones); err != nil {
+		return nil, fmt.Errorf("failed to lock phone numbers: %w", err)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, fmt.Errorf("failed to check duplicate calls: %w", err)
+	}
+	return duplicates, nil
+}
+
+// UpdateCallsStatus applies status changes guarded like UpdateCallStatus and
+// returns an error per change that was not applied. In atomic mode a single
+// failed change rolls every change back.
+func (r *CallsRepo) UpdateCallsStatus(ctx context.Context, changes []entity.StatusChange, atomic bool) ([]error, error) {
+	itemErrs := make([]error, len(changes))
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		batch := &pgx.Batch{}
+		for i, ch := range changes {
+			batch.Queue(queryUpdateCallStatus, ch.CallID, ch.UserID, ch.OrgID, ch.From, ch.To).Exec(func(ct pgconn.CommandTag) error {
+				if ct.RowsAffected() == 0 {
+					itemErrs[i] = ErrStatusChanged
+				}
+				return nil
+			})
+		}
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to update calls status: %w", err)
+		}
+
+		var events []entity.CallEvent
+		for i, ch := range changes {
+			if itemErrs[i] == nil {
+				events = append(events, statusEvent(ch))
+			}
+		}
+		if atomic && len(events) < len(changes) {
+			return errBulkRollback
+		}
+		return recordEvents(ctx, tx, events)
+	})
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		return nil, err
+	}
+
+	return itemErrs, nil
+}
+
+// DeleteCalls moves calls to the trash and returns an error per call that was
+// not found. In atomic mode a single missing call rolls every deletion back.
+func (r *CallsRepo) DeleteCalls(ctx context.Context, userID, orgID int64, ids []int64, atomic bool) ([]error, error) {
+	itemErrs := make([]error, len(ids))
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		batch := &pgx.Batch{}
+		for i, id := range ids {
+			batch.Queue(queryDeleteCall, id, userID, orgID).Exec(func(ct pgconn.CommandTag) error {
+				if ct.RowsAffected() == 0 {
+					itemErrs[i] = ErrCallNotFound
+				}
+				return nil
+			})
+		}
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to delete calls: %w", err)
+		}
+
+		var events []entity.CallEvent
+		for i, id := range ids {
+			if itemErrs[i] == nil {
+				events = append(events, entity.CallEvent{CallID: id, UserID: userID, Type: entity.EventDeleted})
+			}
+		}
+		if atomic && len(events) < len(ids) {
+			return errBulkRollback
+		}
+		return recordEvents(ctx, tx, events)
+	})
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		return nil, err
+	}
+
+	return itemErrs, nil
+}
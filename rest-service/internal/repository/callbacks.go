@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrNoCallback = errors.New("call has no scheduled callback")
+
+const (
+	queryScheduleCallback  = `UPDATE calls SET callback_at = $4, callback_timezone = $5, callback_notified_at = NULL, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` RETURNING ` + callColumns
+	querySnoozeCallback    = `UPDATE calls SET callback_at = GREATEST(callback_at, CURRENT_TIMESTAMP) + make_interval(secs => $4), callback_notified_at = NULL, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` RETURNING ` + callColumns
+	queryCancelCallback    = `UPDATE calls SET callback_at = NULL, callback_timezone = NULL, callback_notified_at = NULL, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` RETURNING ` + callColumns
+	queryUpcomingCallbacks = `SELECT ` + callColumns + ` FROM calls
+WHERE (user_id = $1 OR assignee_id = $1 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $4 AND user_id = $1))
+	AND deleted_at IS NULL
+	AND status NOT IN ('resolved', 'closed')
+	AND callback_at <= $2
+ORDER BY callback_at, id
+LIMIT $3`
+)
+
+// queryMarkCallbacksDue marks the due callbacks of open calls as notified, so
+// each of them is returned once. Snoozing or rescheduling a callback clears
+// the mark.
+const queryMarkCallbacksDue = `UPDATE calls SET callback_notified_at = CURRENT_TIMESTAMP
+WHERE callback_at <= CURRENT_TIMESTAMP
+	AND callback_notified_at IS NULL
+	AND deleted_at IS NULL
+	AND status NOT IN ('resolved', 'closed')
+RETURNING id, user_id, assignee_id, callback_at, callback_timezone`
+
+// ScheduleCallback sets the callback time of a call, replacing the previous one.
+func (r *CallsRepo) ScheduleCallback(ctx context.Context, cb entity.Callback) (*entity.CallResponse, error) {
+	return r.changeCallback(ctx, cb.CallID, cb.UserID, cb.OrgID, entity.EventCallbackScheduled, false,
+		queryScheduleCallback, cb.At, cb.Timezone)
+}
+
+// SnoozeCallback postpones the callback of a call. It returns ErrNoCallback if
+// the call has none.
+func (r *CallsRepo) SnoozeCallback(ctx context.Context, s entity.CallbackSnooze) (*entity.CallResponse, error) {
+	return r.changeCallback(ctx, s.CallID, s.UserID, s.OrgID, entity.EventCallbackSnoozed, true,
+		querySnoozeCallback, s.By.Seconds())
+}
+
+// CancelCallback removes the callback of a call. It returns ErrNoCallback if
+// the call has none.
+func (r *CallsRepo) CancelCallback(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	return r.changeCallback(ctx, callID, userID, orgID, entity.EventCallbackCancelled, true, queryCancelCallback)
+}
+
+// changeCallback runs query on the locked call, with the call, user and
+// organization IDs as the first three arguments, and records the change of
+// the callback time.
+func (r *CallsRepo) changeCallback(ctx context.Context, callID, userID, orgID int64, eventType string, requireCallback bool, query string, args ...any) (*entity.CallResponse, error) {
+	var before, after entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := scanCall(tx.QueryRow(ctx, queryLockUserCall, callID, userID, orgID), &before); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrCallNotFound
+			}
+			return fmt.Errorf("failed to lock call: %w", err)
+		}
+
+		if requireCallback && before.CallbackAt == nil {
+			return ErrNoCallback
+		}
+
+		if err := scanCall(tx.QueryRow(ctx, query, append([]any{callID, userID, orgID}, args...)...), &after); err != nil {
+			return fmt.Errorf("failed to change callback: %w", err)
+		}
+
+		return recordEvents(ctx, tx, []entity.CallEvent{{
+			CallID:   callID,
+			UserID:   userID,
+			Type:     eventType,
+			Field:    "callback_at",
+			OldValue: callbackValue(before.CallbackAt),
+			NewValue: callbackValue(after.CallbackAt),
+		}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &after, nil
+}
+
+// GetUpcomingCallbacks returns up to q.Limit open calls with a callback due
+// before q.Before, the earliest first.
+func (r *CallsRepo) GetUpcomingCallbacks(ctx context.Context, q entity.CallbacksQuery) ([]entity.CallResponse, error) {
+	rows, err := r.Pool.Query(ctx, queryUpcomingCallbacks, q.UserID, q.Before, q.Limit, q.OrgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming callbacks: %w", err)
+	}
+	defer rows.Close()
+
+	calls := make([]entity.CallResponse, 0, q.Limit)
+	for rows.Next() {
+		var call entity.CallResponse
+		if err := scanCall(rows, &call); err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return calls, nil
+}
+
+// MarkCallbacksDue returns the callbacks that have become due since the last
+// check and records a callback_due event for each of them.
+func (r *CallsRepo) MarkCallbacksDue(ctx context.Context) ([]entity.CallbackDue, error) {
+	var due []entity.CallbackDue
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, queryMarkCallbacksDue)
+		if err != nil {
+			return fmt.Errorf("failed to mark callbacks due: %w", err)
+		}
+
+		var events []entity.CallEvent
+		for rows.Next() {
+			var d entity.CallbackDue
+			if err := rows.Scan(&d.CallID, &d.UserID, &d.AssigneeID, &d.CallbackAt, &d.Timezone); err != nil {
+				rows.Close()
+				return err
+			}
+			d.CallbackAt = inZone(d.CallbackAt, d.Timezone)
+			due = append(due, d)
+			events = append(events, entity.CallEvent{
+				CallID:   d.CallID,
+				Type:     entity.EventCallbackDue,
+				Field:    "callback_at",
+				NewValue: callbackValue(&d.CallbackAt),
+			})
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		return recordEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return due, nil
+}
+
+func callbackValue(at *time.Time) *string {
+	if at == nil {
+		return nil
+	}
+	s := at.Format(time.RFC3339)
+	return &s
+}
+
+// callbackZone scans the time zone of a callback and moves the callback
+// time, scanned before it, into that zone.
+type callbackZone struct {
+	at **time.Time
+	tz *string
+}
+
+func (s callbackZone) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*s.tz = ""
+	case string:
+		*s.tz = v
+		if *s.at != nil {
+			t := inZone(**s.at, v)
+			*s.at = &t
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into time zone", src)
+	}
+	return nil
+}
+
+// inZone returns t in the named time zone, or unchanged if the zone is unknown.
+func inZone(t time.Time, name string) time.Time {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return t
+	}
+	return t.In(loc)
+}
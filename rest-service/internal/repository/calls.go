@@ -4,70 +4,112 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"calls-service/pkg/phone"
 	"calls-service/pkg/postgres"
 	"calls-service/rest-service/internal/entity"
 
-	"github.com/rs/zerolog/log"
+	"github.com/jackc/pgx/v5"
 )
 
-var ErrCallNotFound = errors.New("call not found")
+var (
+	ErrCallNotFound      = errors.New("call not found")
+	ErrVersionConflict   = errors.New("call version conflict")
+	ErrStatusChanged     = errors.New("call status changed")
+	ErrAssigneeNotMember = errors.New("assignee is not a member of the organization of the call")
+)
+
+// callSortColumns whitelists the columns a calls list may be ordered by,
+// together with the SQL type used to cast the keyset cursor value.
+var callSortColumns = map[string]struct{ column, cast string }{
+	entity.SortByCreatedAt:  {"created_at", "timestamp"},
+	entity.SortByClientName: {"client_name", "text"},
+	entity.SortByStatus:     {"status", "text"},
+	entity.SortByID:         {"id", "bigint"},
+}
+
+const callColumns = `id, client_name, phone_number, phone_e164, description, status, (SELECT label FROM call_statuses WHERE code = calls.status), created_at, updated_at, version, assignee_id, priority, due_at, sla_status, deleted_at, closed_at, client_id, ` + callTags + `, callback_at, callback_timezone`
+
+// callTags selects the names of the tags attached to a call.
+const callTags = `ARRAY(SELECT t.name FROM call_tags ct JOIN tags t ON t.id = ct.tag_id WHERE ct.call_id = calls.id ORDER BY lower(t.name))`
+
+// activeUserCall matches call $1 if it is visible to user $2 in organization
+// $3 and not in the trash. A call is visible to the user who created it, to
+// its assignee and to the members of its organization in sessions where that
+// organization is active.
+const activeUserCall = `id = $1 AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND deleted_at IS NULL`
 
 const (
-	querySaveCall         = `INSERT INTO calls (client_name, phone_number, description, user_id) VALUES ($1, $2, $3, $4)`
-	queryGetUserCalls     = `SELECT id, client_name, phone_number, description, status, created_at FROM calls WHERE user_id = $1 ORDER BY created_at DESC`
-	queryGetUserCallByID  = `SELECT id, client_name, phone_number, description, status, created_at FROM calls WHERE id = $1 AND user_id = $2`
-	queryUpdateCallStatus = `UPDATE calls SET status = $1 WHERE id = $2 AND user_id = $3`
-	queryDeleteCall       = `DELETE FROM calls WHERE id = $1 AND user_id = $2`
+	querySaveCall         = `WITH client AS (` + queryUpsertClient + `) INSERT INTO calls (client_name, phone_number, description, user_id, priority, due_at, phone_e164, client_id, org_id) VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP + make_interval(secs => $6), $7, (SELECT id FROM client), (SELECT org_id FROM memberships WHERE org_id = $8 AND user_id = $4)) RETURNING ` + callColumns
+	queryGetUserCalls     = `SELECT ` + callColumns + ` FROM calls`
+	queryGetUserCallByID  = `SELECT ` + callColumns + ` FROM calls WHERE ` + activeUserCall
+	queryUpdateCallStatus = `UPDATE calls SET status = $5, closed_at = CASE WHEN $5 = 'closed' THEN CURRENT_TIMESTAMP END, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` AND status = $4`
+	queryUpdateCall       = `WITH client AS (` + queryRelinkClient + `) UPDATE calls SET client_id = CASE WHEN $10::text IS NULL THEN client_id ELSE (SELECT id FROM client) END, client_name = COALESCE($5, client_name), phone_number = COALESCE($6, phone_number), phone_e164 = COALESCE($10, phone_e164), description = COALESCE($7, description), priority = COALESCE($8, priority), due_at = COALESCE(created_at + make_interval(secs => $9), due_at), sla_status = CASE WHEN $9 IS NULL THEN sla_status WHEN created_at + make_interval(secs => $9) <= CURRENT_TIMESTAMP THEN 'breached' WHEN created_at + make_interval(secs => $9) <= CURRENT_TIMESTAMP + make_interval(secs => $11) THEN 'at_risk' ELSE 'ok' END, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` AND version = $4 RETURNING ` + callColumns
+	queryCallExists       = `SELECT EXISTS (SELECT 1 FROM calls WHERE ` + activeUserCall + `)`
+	queryLockUserCall     = `SELECT ` + callColumns + ` FROM calls WHERE ` + activeUserCall + ` FOR UPDATE`
+	queryAssigneeInOrg    = `SELECT org_id IS NULL OR EXISTS (SELECT 1 FROM memberships m WHERE m.org_id = calls.org_id AND m.user_id = $2) FROM calls WHERE id = $1`
+	queryAssignCall       = `UPDATE calls SET assignee_id = $4, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall + ` RETURNING ` + callColumns
+	queryDeleteCall       = `UPDATE calls SET deleted_at = CURRENT_TIMESTAMP, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE ` + activeUserCall
+	queryRestoreCall      = `UPDATE calls SET deleted_at = NULL, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE id = $1 AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND deleted_at IS NOT NULL AND merged_into IS NULL RETURNING ` + callColumns
+	queryPurgeCalls       = `WITH purged AS (DELETE FROM calls WHERE deleted_at < CURRENT_TIMESTAMP - make_interval(secs => $1) RETURNING id, user_id, assignee_id, org_id), queued AS (INSERT INTO outbox (call_id, event_type, payload) SELECT id, $2, jsonb_build_object('event', $2::text, 'call_id', id, 'user_id', 0, 'owner_id', user_id, 'assignee_id', assignee_id, 'org_id', COALESCE(org_id, 0), 'occurred_at', CURRENT_TIMESTAMP) FROM purged ORDER BY id) SELECT (SELECT count(*) FROM purged), ARRAY(SELECT a.storage_key FROM call_attachments a JOIN purged p ON p.id = a.call_id)`
 )
 
-func (r *CallsRepo) SaveCall(ctx context.Context, call entity.Call) error {
-	tx, err := r.Pool.Begin(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
+// SaveCall inserts a call and links it to the client with the same phone
+// number, creating the client if there is none yet. With call.DuplicateWithin
+// set it returns a *DuplicateCallError instead if the number already has a
+// recent open call.
+func (r *CallsRepo) SaveCall(ctx context.Context, call entity.Call) (int64, error) {
+	var saved entity.CallResponse
 
-	defer func() {
-		if err := tx.Rollback(ctx); err != nil && !postgres.IsTxClosed(err) {
-			log.Error().Err(err).Msg("failed to rollback transaction")
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if call.DuplicateWithin > 0 {
+			if err := checkDuplicateCall(ctx, tx, call); err != nil {
+				return err
+			}
 		}
-	}()
 
-	_, err = tx.Exec(ctx, querySaveCall,
-		call.ClientName,
-		call.PhoneNumber,
-		call.Description,
-		call.UserID,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to execute insert: %w", err)
-	}
+		err := scanCall(tx.QueryRow(ctx, querySaveCall,
+			call.ClientName,
+			call.PhoneNumber,
+			call.Description,
+			call.UserID,
+			call.Priority,
+			call.DueIn.Seconds(),
+			call.PhoneE164,
+			call.OrgID,
+		), &saved)
+		if err != nil {
+			return fmt.Errorf("failed to execute insert: %w", err)
+		}
 
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return recordEvents(ctx, tx, diffEvents(entity.EventCreated, call.UserID, nil, &saved))
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return saved.ID, nil
 }
 
-func (r *CallsRepo) GetUserCalls(ctx context.Context, userID int64) ([]entity.CallResponse, error) {
-	rows, err := r.Pool.Query(ctx, queryGetUserCalls, userID)
+// GetUserCalls returns up to q.Limit calls matching the query, ordered by the
+// requested column with id as a tie-breaker so the keyset cursor stays stable.
+func (r *CallsRepo) GetUserCalls(ctx context.Context, q entity.CallsQuery) ([]entity.CallResponse, error) {
+	sql, args, err := userCallsSQL(q)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var calls []entity.CallResponse
+	calls := make([]entity.CallResponse, 0, q.Limit)
 	for rows.Next() {
 		var call entity.CallResponse
-		if err := rows.Scan(
-			&call.ID,
-			&call.ClientName,
-			&call.PhoneNumber,
-			&call.Description,
-			&call.Status,
-			&call.CreatedAt,
-		); err != nil {
+		if err := scanCall(rows, &call); err != nil {
 			return nil, err
 		}
 		calls = append(calls, call)
@@ -80,18 +122,141 @@ func (r *CallsRepo) GetUserCalls(ctx context.Context, userID int64) ([]entity.Ca
 	return calls, nil
 }
 
-func (r *CallsRepo) GetUserCallByID(ctx context.Context, callID, userID int64) (*entity.CallResponse, error) {
-	var call entity.CallResponse
+// userCallsSQL builds the list query for q. A zero q.Limit selects every
+// matching call.
+func userCallsSQL(q entity.CallsQuery) (string, []any, error) {
+	sort, ok := callSortColumns[q.SortBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported sort field %q", q.SortBy)
+	}
+
+	var b queryBuilder
+	b.where("(user_id = ? OR assignee_id = ? OR org_id IN (SELECT org_id FROM memberships WHERE org_id = ? AND user_id = ?))", q.UserID, q.UserID, q.OrgID, q.UserID)
+	if q.Deleted {
+		b.where("deleted_at IS NOT NULL AND merged_into IS NULL")
+	} else {
+		b.where("deleted_at IS NULL")
+	}
+	applyCallsFilter(&b, q)
+
+	op, dir := ">", "ASC"
+	if q.SortDesc {
+		op, dir = "<", "DESC"
+	}
 
-	err := r.Pool.QueryRow(ctx, queryGetUserCallByID, callID, userID).Scan(
+	if q.After != nil {
+		if sort.column == "id" {
+			b.where("id "+op+" ?", q.After.ID)
+		} else {
+			b.where(fmt.Sprintf("(%s, id) %s (?::%s, ?)", sort.column, op, sort.cast), q.After.Value, q.After.ID)
+		}
+	}
+
+	sql := queryGetUserCalls + b.whereClause()
+	if sort.column == "id" {
+		sql += fmt.Sprintf(" ORDER BY id %s", dir)
+	} else {
+		sql += fmt.Sprintf(" ORDER BY %s %s, id %s", sort.column, dir, dir)
+	}
+	if q.Limit > 0 {
+		sql += " LIMIT " + b.arg(q.Limit)
+	}
+
+	return sql, b.args, nil
+}
+
+func applyCallsFilter(b *queryBuilder, q entity.CallsQuery) {
+	if q.AssigneeID != 0 {
+		b.where("assignee_id = ?", q.AssigneeID)
+	}
+	if q.ClientID != 0 {
+		b.where("client_id = ?", q.ClientID)
+	}
+	if q.Status != "" {
+		b.where("status = ?", q.Status)
+	}
+	if q.Priority != "" {
+		b.where("priority = ?", q.Priority)
+	}
+	if q.SLAStatus != "" {
+		b.where("sla_status = ?", q.SLAStatus)
+	}
+	if q.Tag != "" {
+		b.where("EXISTS (SELECT 1 FROM call_tags ct JOIN tags t ON t.id = ct.tag_id WHERE ct.call_id = calls.id AND lower(t.name) = lower(?))", q.Tag)
+	}
+	if !q.CreatedFrom.IsZero() {
+		b.where("created_at >= ?", q.CreatedFrom.UTC().Format(time.RFC3339Nano))
+	}
+	if !q.CreatedTo.IsZero() {
+		b.where("created_at <= ?", q.CreatedTo.UTC().Format(time.RFC3339Nano))
+	}
+	if q.PhoneNumber != "" {
+		b.where(`phone_number LIKE ? ESCAPE '\'`, containsPattern(q.PhoneNumber))
+	}
+	if q.ClientName != "" {
+		b.where(`client_name ILIKE ? ESCAPE '\'`, containsPattern(q.ClientName))
+	}
+}
+
+func scanCall(row pgx.Row, call *entity.CallResponse) error {
+	return row.Scan(callFields(call)...)
+}
+
+// callFields returns scan destinations in the order of callColumns.
+func callFields(call *entity.CallResponse) []any {
+	return []any{
 		&call.ID,
 		&call.ClientName,
 		&call.PhoneNumber,
+		phoneScanner{&call.PhoneE164, &call.PhoneNational},
 		&call.Description,
 		&call.Status,
+		&call.StatusLabel,
 		&call.CreatedAt,
-	)
+		&call.UpdatedAt,
+		&call.Version,
+		&call.AssigneeID,
+		&call.Priority,
+		&call.DueAt,
+		&call.SLAStatus,
+		&call.DeletedAt,
+		&call.ClosedAt,
+		&call.ClientID,
+		&call.Tags,
+		&call.CallbackAt,
+		callbackZone{&call.CallbackAt, &call.CallbackTimezone},
+	}
+}
+
+// phoneScanner scans a nullable E.164 number together with its display format.
+type phoneScanner struct {
+	e164, national *string
+}
+
+func (s phoneScanner) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*s.e164, *s.national = "", ""
+	case string:
+		*s.e164, *s.national = v, phone.Format(v)
+	default:
+		return fmt.Errorf("cannot scan %T into phone number", src)
+	}
+	return nil
+}
 
+func seconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+	s := d.Seconds()
+	return &s
+}
+
+func (r *CallsRepo) GetUserCallByID(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	var call entity.CallResponse
+
+	err := scanCall(r.Pool.QueryRow(ctx, queryGetUserCallByID, callID, userID, orgID), &call)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, ErrCallNotFound
@@ -102,28 +267,195 @@ func (r *CallsRepo) GetUserCallByID(ctx context.Context, callID, userID int64) (
 	return &call, nil
 }
 
-func (r *CallsRepo) UpdateCallStatus(ctx context.Context, callID int64, userID int64, newStatus string) error {
-	cmdTag, err := r.Pool.Exec(ctx, queryUpdateCallStatus, newStatus, callID, userID)
-	if err != nil {
-		return fmt.Errorf("failed to update call status: %w", err)
+// UpdateCallStatus moves the call to ch.To only if it is still in ch.From, so a
+// transition validated against a stale status is never applied.
+func (r *CallsRepo) UpdateCallStatus(ctx context.Context, ch entity.StatusChange) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		cmdTag, err := tx.Exec(ctx, queryUpdateCallStatus, ch.CallID, ch.UserID, ch.OrgID, ch.From, ch.To)
+		if err != nil {
+			return fmt.Errorf("failed to update call status: %w", err)
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			var exists bool
+			if err := tx.QueryRow(ctx, queryCallExists, ch.CallID, ch.UserID, ch.OrgID).Scan(&exists); err != nil {
+				return fmt.Errorf("failed to check call: %w", err)
+			}
+			if !exists {
+				return ErrCallNotFound
+			}
+			return ErrStatusChanged
+		}
+
+		return recordEvents(ctx, tx, []entity.CallEvent{statusEvent(ch)})
+	})
+}
+
+func statusEvent(ch entity.StatusChange) entity.CallEvent {
+	return entity.CallEvent{
+		CallID:   ch.CallID,
+		UserID:   ch.UserID,
+		Type:     entity.EventStatusChanged,
+		Field:    "status",
+		OldValue: &ch.From,
+		NewValue: &ch.To,
 	}
+}
+
+// UpdateCall applies a partial update guarded by the call version, if any, and records
+// every changed field. A new phone number moves the call to the client of
+// that number.
+func (r *CallsRepo) UpdateCall(ctx context.Context, upd entity.CallUpdate) (*entity.CallResponse, error) {
+	var before, after entity.CallResponse
 
-	if cmdTag.RowsAffected() == 0 {
-		return ErrCallNotFound
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := scanCall(tx.QueryRow(ctx, queryLockUserCall, upd.ID, upd.UserID, upd.OrgID), &before); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrCallNotFound
+			}
+			return fmt.Errorf("failed to lock call: %w", err)
+		}
+
+		if upd.Version == 0 {
+			upd.Version = before.Version
+		} else if before.Version != upd.Version {
+			return ErrVersionConflict
+		}
+
+		err := scanCall(tx.QueryRow(ctx, queryUpdateCall,
+			upd.ID,
+			upd.UserID,
+			upd.OrgID,
+			upd.Version,
+			upd.ClientName,
+			upd.PhoneNumber,
+			upd.Description,
+			upd.Priority,
+			seconds(upd.DueIn),
+			upd.PhoneE164,
+			upd.WarnBefore.Seconds(),
+		), &after)
+		if err != nil {
+			return fmt.Errorf("failed to update call: %w", err)
+		}
+
+		return recordEvents(ctx, tx, diffEvents(entity.EventUpdated, upd.UserID, &before, &after))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &after, nil
 }
 
-func (r *CallsRepo) DeleteCall(ctx context.Context, callID int64, userID int64) error {
-	cmdTag, err := r.Pool.Exec(ctx, queryDeleteCall, callID, userID)
+// AssignCall sets or clears the call assignee and records the change. The
+// assignee of a call of an organization must be a member of it. An assignment
+// that does not change anything leaves the call untouched.
+func (r *CallsRepo) AssignCall(ctx context.Context, a entity.Assignment) (*entity.CallResponse, error) {
+	var before, after entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := scanCall(tx.QueryRow(ctx, queryLockUserCall, a.CallID, a.UserID, a.OrgID), &before); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrCallNotFound
+			}
+			return fmt.Errorf("failed to lock call: %w", err)
+		}
+
+		if sameAssignee(before.AssigneeID, a.AssigneeID) {
+			after = before
+			return nil
+		}
+
+		if a.AssigneeID != nil {
+			var member bool
+			if err := tx.QueryRow(ctx, queryAssigneeInOrg, a.CallID, *a.AssigneeID).Scan(&member); err != nil {
+				return fmt.Errorf("failed to check assignee: %w", err)
+			}
+			if !member {
+				return ErrAssigneeNotMember
+			}
+		}
+
+		if err := scanCall(tx.QueryRow(ctx, queryAssignCall, a.CallID, a.UserID, a.OrgID, a.AssigneeID), &after); err != nil {
+			return fmt.Errorf("failed to assign call: %w", err)
+		}
+
+		eventType := entity.EventAssigned
+		if a.AssigneeID == nil {
+			eventType = entity.EventUnassigned
+		}
+		return recordEvents(ctx, tx, diffEvents(eventType, a.UserID, &before, &after))
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete call: %w", err)
+		return nil, err
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return ErrCallNotFound
+	return &after, nil
+}
+
+func sameAssignee(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+	return *a == *b
+}
 
-	return nil
+// DeleteCall moves the call to the trash; it stays there until restored or purged.
+func (r *CallsRepo) DeleteCall(ctx context.Context, callID, userID, orgID int64) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		cmdTag, err := tx.Exec(ctx, queryDeleteCall, callID, userID, orgID)
+		if err != nil {
+			return fmt.Errorf("failed to delete call: %w", err)
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			return ErrCallNotFound
+		}
+
+		return recordEvents(ctx, tx, []entity.CallEvent{{
+			CallID: callID,
+			UserID: userID,
+			Type:   entity.EventDeleted,
+		}})
+	})
+}
+
+func (r *CallsRepo) RestoreCall(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	var restored entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := scanCall(tx.QueryRow(ctx, queryRestoreCall, callID, userID, orgID), &restored); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrCallNotFound
+			}
+			return fmt.Errorf("failed to restore call: %w", err)
+		}
+
+		return recordEvents(ctx, tx, []entity.CallEvent{{
+			CallID: callID,
+			UserID: userID,
+			Type:   entity.EventRestored,
+		}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
+}
+
+// PurgeCalls permanently deletes calls that have been in the trash for longer
+// than retention and leaves a purged message per call in the outbox. Their
+// comments and attachments go with them; the storage keys of the attachment
+// files are returned along with the number of calls.
+func (r *CallsRepo) PurgeCalls(ctx context.Context, retention time.Duration) (int64, []string, error) {
+	var (
+		n    int64
+		keys []string
+	)
+	if err := r.Pool.QueryRow(ctx, queryPurgeCalls, retention.Seconds(), entity.EventPurged).Scan(&n, &keys); err != nil {
+		return 0, nil, fmt.Errorf("failed to purge calls: %w", err)
+	}
+	return n, keys, nil
 }
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrClientNotFound = errors.New("client not found")
+
+// queryUpsertClient returns the ID of the client with E.164 phone $7,
+// creating it if needed.
+const queryUpsertClient = `INSERT INTO clients (phone) VALUES ($7) ON CONFLICT (phone) DO UPDATE SET phone = EXCLUDED.phone RETURNING id`
+
+// queryRelinkClient is queryUpsertClient for the update of a call to E.164
+// phone $10.
+const queryRelinkClient = `INSERT INTO clients (phone) SELECT $10 WHERE $10 IS NOT NULL ON CONFLICT (phone) DO UPDATE SET phone = EXCLUDED.phone RETURNING id`
+
+// clientStats aggregates the active calls of a client visible to the user.
+// Clients are shared by everyone who calls the same number, so the name and
+// the creation time come from those calls only: the client name of the
+// latest one and the time of the first one.
+const clientStats = `SELECT cl.id, (array_agg(c.client_name ORDER BY c.created_at DESC, c.id DESC))[1], cl.phone, min(c.created_at), count(*), max(c.created_at) FROM clients cl JOIN calls c ON c.client_id = cl.id`
+
+const (
+	queryGetClient      = clientStats + ` WHERE cl.id = $1 AND (c.user_id = $2 OR c.assignee_id = $2 OR c.org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND c.deleted_at IS NULL GROUP BY cl.id`
+	queryGetClientsByID = clientStats + ` WHERE cl.id = ANY($1) AND (c.user_id = $2 OR c.assignee_id = $2 OR c.org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND c.deleted_at IS NULL GROUP BY cl.id`
+)
+
+// phoneDigits returns the digits of a phone number.
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+func scanClient(row pgx.Row, client *entity.Client) error {
+	return row.Scan(&client.ID, &client.Name, phoneScanner{&client.PhoneNumber, &client.PhoneNational}, &client.CreatedAt, &client.CallsCount, &client.LastCallAt)
+}
+
+// GetClients returns up to q.Limit clients of the active calls visible to the
+// user, newest first.
+func (r *CallsRepo) GetClients(ctx context.Context, q entity.ClientsQuery) ([]entity.Client, error) {
+	var b queryBuilder
+	b.where("(c.user_id = ? OR c.assignee_id = ? OR c.org_id IN (SELECT org_id FROM memberships WHERE org_id = ? AND user_id = ?))", q.UserID, q.UserID, q.OrgID, q.UserID)
+	b.where("c.deleted_at IS NULL")
+	if q.After != nil {
+		b.where("cl.id < ?", q.After.ID)
+	}
+	var having string
+	if text := strings.TrimSpace(q.Q); text != "" {
+		if phone := phoneDigits(text); phone != "" && strings.IndexFunc(text, unicode.IsLetter) < 0 {
+			b.where(`cl.phone LIKE ? ESCAPE '\'`, containsPattern(phone))
+		} else {
+			having = ` HAVING bool_or(c.client_name ILIKE ` + b.arg(containsPattern(text)) + ` ESCAPE '\')`
+		}
+	}
+
+	sql := clientStats + b.whereClause() + " GROUP BY cl.id" + having + " ORDER BY cl.id DESC LIMIT " + b.arg(q.Limit)
+
+	rows, err := r.Pool.Query(ctx, sql, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]entity.Client, 0, q.Limit)
+	for rows.Next() {
+		var client entity.Client
+		if err := scanClient(rows, &client); err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// GetClient returns a client if one of its active calls is visible to the user.
+func (r *CallsRepo) GetClient(ctx context.Context, clientID, userID, orgID int64) (*entity.Client, error) {
+	var client entity.Client
+
+	if err := scanClient(r.Pool.QueryRow(ctx, queryGetClient, clientID, userID, orgID), &client); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, ErrClientNotFound
+		}
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	return &client, nil
+}
+
+// GetClientsByIDs returns the clients from ids that are visible to the user,
+// in no particular order.
+func (r *CallsRepo) GetClientsByIDs(ctx context.Context, userID, orgID int64, ids []int64) ([]entity.Client, error) {
+	rows, err := r.Pool.Query(ctx, queryGetClientsByID, ids, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]entity.Client, 0, len(ids))
+	for rows.Next() {
+		var client entity.Client
+		if err := scanClient(rows, &client); err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
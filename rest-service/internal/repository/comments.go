@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrNotCommentAuthor = errors.New("comment belongs to another user")
+)
+
+const commentColumns = `id, call_id, author_id, body, is_internal, created_at, updated_at, edit_count`
+
+const (
+	querySaveComment   = `INSERT INTO call_comments (call_id, author_id, body, is_internal) VALUES ($1, $2, $3, $4) RETURNING ` + commentColumns
+	queryGetComments   = `SELECT ` + commentColumns + ` FROM call_comments WHERE call_id = $1 AND ($2::boolean IS NULL OR is_internal = $2) ORDER BY id`
+	queryUpdateComment = `UPDATE call_comments SET body = COALESCE($4, body), is_internal = COALESCE($5, is_internal), updated_at = CURRENT_TIMESTAMP, edit_count = edit_count + 1 WHERE id = $1 AND call_id = $2 AND author_id = $3 RETURNING ` + commentColumns
+	queryDeleteComment = `DELETE FROM call_comments WHERE id = $1 AND call_id = $2 AND author_id = $3`
+	queryCommentExists = `SELECT EXISTS (SELECT 1 FROM call_comments WHERE id = $1 AND call_id = $2)`
+)
+
+// queryGetCallsComments selects the comments of the calls among $1 that are
+// visible to user $2 in organization $3 and not in the trash.
+const queryGetCallsComments = `SELECT ` + commentColumns + ` FROM call_comments WHERE call_id IN (SELECT id FROM calls WHERE id = ANY($1) AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND deleted_at IS NULL) ORDER BY call_id, id`
+
+func scanComment(row pgx.Row, comment *entity.Comment) error {
+	return row.Scan(
+		&comment.ID,
+		&comment.CallID,
+		&comment.AuthorID,
+		&comment.Body,
+		&comment.IsInternal,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&comment.EditCount,
+	)
+}
+
+func (r *CallsRepo) SaveComment(ctx context.Context, comment entity.Comment) (*entity.Comment, error) {
+	var saved entity.Comment
+
+	err := scanComment(r.Pool.QueryRow(ctx, querySaveComment,
+		comment.CallID,
+		comment.AuthorID,
+		comment.Body,
+		comment.IsInternal,
+	), &saved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// GetComments returns comments of a call; internal filters by the is_internal flag when set.
+func (r *CallsRepo) GetComments(ctx context.Context, callID int64, internal *bool) ([]entity.Comment, error) {
+	rows, err := r.Pool.Query(ctx, queryGetComments, callID, internal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := []entity.Comment{}
+	for rows.Next() {
+		var comment entity.Comment
+		if err := scanComment(rows, &comment); err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// GetCallsComments returns the comments of the calls from callIDs that are
+// visible to the user, ordered by call.
+func (r *CallsRepo) GetCallsComments(ctx context.Context, userID, orgID int64, callIDs []int64) ([]entity.Comment, error) {
+	rows, err := r.Pool.Query(ctx, queryGetCallsComments, callIDs, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := []entity.Comment{}
+	for rows.Next() {
+		var comment entity.Comment
+		if err := scanComment(rows, &comment); err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (r *CallsRepo) UpdateComment(ctx context.Context, upd entity.CommentUpdate) (*entity.Comment, error) {
+	var comment entity.Comment
+
+	err := scanComment(r.Pool.QueryRow(ctx, queryUpdateComment,
+		upd.ID,
+		upd.CallID,
+		upd.AuthorID,
+		upd.Body,
+		upd.IsInternal,
+	), &comment)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, r.commentMissError(ctx, upd.ID, upd.CallID)
+		}
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+func (r *CallsRepo) DeleteComment(ctx context.Context, callID, commentID, authorID int64) error {
+	cmdTag, err := r.Pool.Exec(ctx, queryDeleteComment, commentID, callID, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return r.commentMissError(ctx, commentID, callID)
+	}
+
+	return nil
+}
+
+// commentMissError explains why a comment guarded by its author was not changed.
+func (r *CallsRepo) commentMissError(ctx context.Context, commentID, callID int64) error {
+	var exists bool
+	if err := r.Pool.QueryRow(ctx, queryCommentExists, commentID, callID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check comment: %w", err)
+	}
+	if !exists {
+		return ErrCommentNotFound
+	}
+	return ErrNotCommentAuthor
+}
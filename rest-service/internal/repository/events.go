@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const queryGetCallHistory = `SELECT id, call_id, COALESCE(user_id, 0), event_type, COALESCE(field, ''), old_value, new_value, created_at FROM call_events WHERE call_id = $1 ORDER BY id`
+
+var callEventColumns = []string{"call_id", "user_id", "event_type", "field", "old_value", "new_value"}
+
+// recordEvents writes call events inside the transaction of the change they
+// describe, along with the outbox messages about the change. Events with a
+// zero UserID are stored without a user.
+func recordEvents(ctx context.Context, tx pgx.Tx, events []entity.CallEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"call_events"}, callEventColumns,
+		pgx.CopyFromSlice(len(events), func(i int) ([]any, error) {
+			e := events[i]
+			var field *string
+			if e.Field != "" {
+				field = &e.Field
+			}
+			var userID *int64
+			if e.UserID != 0 {
+				userID = &e.UserID
+			}
+			return []any{e.CallID, userID, e.Type, field, e.OldValue, e.NewValue}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record call events: %w", err)
+	}
+
+	return writeOutbox(ctx, tx, events)
+}
+
+func (r *CallsRepo) GetCallHistory(ctx context.Context, callID int64) ([]entity.CallEvent, error) {
+	rows, err := r.Pool.Query(ctx, queryGetCallHistory, callID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call history: %w", err)
+	}
+	defer rows.Close()
+
+	events := []entity.CallEvent{}
+	for rows.Next() {
+		var e entity.CallEvent
+		if err := rows.Scan(
+			&e.ID,
+			&e.CallID,
+			&e.UserID,
+			&e.Type,
+			&e.Field,
+			&e.OldValue,
+			&e.NewValue,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// trackedFields returns the call fields whose changes are kept in the history.
+// Optional fields are left out while they are unset.
+func trackedFields(call *entity.CallResponse) map[string]string {
+	fields := map[string]string{
+		"client_name":  call.ClientName,
+		"phone_number": call.PhoneNumber,
+		"description":  call.Description,
+		"status":       call.Status,
+		"priority":     call.Priority,
+	}
+	if call.AssigneeID != nil {
+		fields["assignee_id"] = strconv.FormatInt(*call.AssigneeID, 10)
+	}
+	return fields
+}
+
+// trackedFieldOrder keeps events of one change in a stable order.
+var trackedFieldOrder = []string{"client_name", "phone_number", "description", "status", "priority", "assignee_id"}
+
+// diffEvents builds one event per tracked field that differs between before and
+// after. A nil before describes creation, a nil after describes deletion.
+func diffEvents(eventType string, userID int64, before, after *entity.CallResponse) []entity.CallEvent {
+	var oldFields, newFields map[string]string
+	callID := int64(0)
+	if before != nil {
+		oldFields = trackedFields(before)
+		callID = before.ID
+	}
+	if after != nil {
+		newFields = trackedFields(after)
+		callID = after.ID
+	}
+
+	var events []entity.CallEvent
+	for _, field := range trackedFieldOrder {
+		oldValue, hadOld := oldFields[field]
+		newValue, hasNew := newFields[field]
+		if hadOld && hasNew && oldValue == newValue {
+			continue
+		}
+
+		e := entity.CallEvent{CallID: callID, UserID: userID, Type: eventType, Field: field}
+		if hadOld {
+			e.OldValue = &oldValue
+		}
+		if hasNew {
+			e.NewValue = &newValue
+		}
+		events = append(events, e)
+	}
+
+	return events
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// ExportCalls passes every call matching q to fn as it is read from the
+// database, so an export never holds more than one row in memory. q.Limit and
+// q.After are ignored. An error from fn stops the export and is returned.
+func (r *CallsRepo) ExportCalls(ctx context.Context, q entity.CallsQuery, fn func(entity.CallResponse) error) error {
+	q.Limit, q.After = 0, nil
+
+	sql, args, err := userCallsSQL(q)
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("failed to export calls: %w", err)
+	}
+	defer rows.Close()
+
+	var call entity.CallResponse
+	for rows.Next() {
+		call = entity.CallResponse{}
+		if err := scanCall(rows, &call); err != nil {
+			return err
+		}
+		if err := fn(call); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
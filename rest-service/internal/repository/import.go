@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const queryCreateImportTable = `CREATE TEMP TABLE import_calls (n BIGINT GENERATED ALWAYS AS IDENTITY, line INT, client_name TEXT, phone_number TEXT, description TEXT, status TEXT, created_at TIMESTAMP, user_id BIGINT, org_id BIGINT, priority TEXT, due_in DOUBLE PRECISION, phone_e164 TEXT, duplicate_of BIGINT, duplicate_row BIGINT, id BIGINT) ON COMMIT DROP`
+
+var importCallColumns = []string{"line", "client_name", "phone_number", "description", "status", "created_at", "user_id", "org_id", "priority", "due_in", "phone_e164"}
+
+// The duplicate check of SaveCall applied to the staged calls: the numbers
+// are locked in a fixed order, then an open staged call created within $1
+// seconds is marked as a duplicate of the latest such call visible to its
+// user or, failing that, of the first such row before it in the file.
+const (
+	queryIndexImportTable   = `CREATE INDEX ON import_calls (phone_e164, n)`
+	queryLockImportedPhones = `SELECT pg_advisory_xact_lock(hashtext(phone_e164)) FROM (SELECT DISTINCT phone_e164 FROM import_calls ORDER BY phone_e164) AS phones`
+	queryMarkDuplicateCalls = `UPDATE import_calls AS staged SET duplicate_of = (
+		SELECT calls.id FROM calls
+		WHERE calls.phone_e164 = staged.phone_e164
+			AND (calls.user_id = staged.user_id OR calls.assignee_id = staged.user_id OR calls.org_id IN (SELECT org_id FROM memberships WHERE org_id = staged.org_id AND user_id = staged.user_id))
+			AND calls.deleted_at IS NULL AND calls.status NOT IN ('resolved', 'closed') AND calls.created_at >= CURRENT_TIMESTAMP - make_interval(secs => $1)
+		ORDER BY calls.created_at DESC, calls.id DESC
+		LIMIT 1
+	)
+	WHERE staged.status NOT IN ('resolved', 'closed') AND COALESCE(staged.created_at, CURRENT_TIMESTAMP) >= CURRENT_TIMESTAMP - make_interval(secs => $1)`
+	queryMarkRepeatedRows = `UPDATE import_calls AS staged SET duplicate_row = (
+		SELECT min(earlier.n) FROM import_calls AS earlier
+		WHERE earlier.phone_e164 = staged.phone_e164 AND earlier.n < staged.n
+			AND earlier.status NOT IN ('resolved', 'closed') AND COALESCE(earlier.created_at, CURRENT_TIMESTAMP) >= CURRENT_TIMESTAMP - make_interval(secs => $1)
+	)
+	WHERE staged.duplicate_of IS NULL AND staged.status NOT IN ('resolved', 'closed') AND COALESCE(staged.created_at, CURRENT_TIMESTAMP) >= CURRENT_TIMESTAMP - make_interval(secs => $1)`
+)
+
+// queryAssignImportedIDs takes call IDs for the staged calls that are not
+// duplicates in file order, so that duplicates of earlier rows can be
+// reported with the ID of the call they repeat.
+const queryAssignImportedIDs = `UPDATE import_calls AS staged SET id = ids.id
+FROM (SELECT n, nextval(pg_get_serial_sequence('calls', 'id')) AS id FROM (SELECT n FROM import_calls WHERE duplicate_of IS NULL AND duplicate_row IS NULL ORDER BY n) AS ordered) AS ids
+WHERE staged.n = ids.n`
+
+const queryGetImportDuplicates = `SELECT staged.line, COALESCE(staged.duplicate_of, earlier.id)
+FROM import_calls AS staged
+LEFT JOIN import_calls AS earlier ON earlier.n = staged.duplicate_row
+WHERE staged.id IS NULL
+ORDER BY staged.n`
+
+// queryInsertImportedCalls moves the staged calls that are not duplicates
+// into calls, links them to their clients and records a created event per
+// tracked field and an outbox message per call with its creator and
+// organization, as SaveCall does. Calls
+// without a creation time are created now.
+const queryInsertImportedCalls = `WITH client AS (
+	INSERT INTO clients (phone)
+	SELECT DISTINCT phone_e164
+	FROM import_calls
+	WHERE id IS NOT NULL
+	ON CONFLICT (phone) DO UPDATE SET phone = EXCLUDED.phone
+	RETURNING id, phone
+), inserted AS (
+	INSERT INTO calls (id, client_name, phone_number, phone_e164, description, status, created_at, updated_at, user_id, priority, due_at, client_id, org_id)
+	SELECT staged.id, client_name, phone_number, phone_e164, description, status, ts, ts, staged.user_id, priority::call_priority, ts + make_interval(secs => due_in), client.id, memberships.org_id
+	FROM (SELECT *, COALESCE(created_at, CURRENT_TIMESTAMP) AS ts FROM import_calls WHERE id IS NOT NULL) AS staged
+	LEFT JOIN client ON client.phone = staged.phone_e164
+	LEFT JOIN memberships ON memberships.org_id = staged.org_id AND memberships.user_id = staged.user_id
+	ORDER BY n
+	RETURNING id, user_id, client_name, phone_number, description, status, priority, org_id
+), events AS (
+	INSERT INTO call_events (call_id, user_id, event_type, field, new_value)
+	SELECT inserted.id, inserted.user_id, $1, f.field, f.value
+	FROM inserted
+	CROSS JOIN LATERAL (VALUES
+		('client_name', inserted.client_name),
+		('phone_number', inserted.phone_number),
+		('description', inserted.description),
+		('status', inserted.status),
+		('priority', inserted.priority::text)
+	) AS f(field, value)
+	RETURNING id, call_id, user_id, field, new_value
+)
+INSERT INTO outbox (call_id, event_type, payload)
+SELECT events.call_id, $1, jsonb_build_object(
+	'event', $1::text,
+	'call_id', events.call_id,
+	'user_id', events.user_id,
+	'owner_id', events.user_id,
+	'org_id', COALESCE(inserted.org_id, 0),
+	'changes', jsonb_agg(jsonb_build_object('field', events.field, 'new_value', events.new_value) ORDER BY events.id),
+	'occurred_at', CURRENT_TIMESTAMP
+)
+FROM events
+JOIN inserted ON inserted.id = events.call_id
+GROUP BY events.call_id, events.user_id, inserted.org_id
+ORDER BY events.call_id`
+
+// ImportCalls streams calls from src into a staging table with COPY and moves
+// them into calls in the same transaction. With a non-zero duplicateWithin
+// the calls SaveCall would refuse as duplicates, counting the earlier rows of
+// src, are left out and reported instead.
+func (r *CallsRepo) ImportCalls(ctx context.Context, src entity.CallSource, duplicateWithin time.Duration) (*entity.ImportResult, error) {
+	res := &entity.ImportResult{}
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, queryCreateImportTable); err != nil {
+			return fmt.Errorf("failed to create import table: %w", err)
+		}
+
+		n, err := tx.CopyFrom(ctx, pgx.Identifier{"import_calls"}, importCallColumns, callCopySource{src})
+		if err != nil {
+			return fmt.Errorf("failed to copy calls: %w", err)
+		}
+
+		if duplicateWithin > 0 {
+			if err := markImportDuplicates(ctx, tx, duplicateWithin); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, queryAssignImportedIDs); err != nil {
+			return fmt.Errorf("failed to assign call IDs: %w", err)
+		}
+		if _, err := tx.Exec(ctx, queryInsertImportedCalls, entity.EventCreated); err != nil {
+			return fmt.Errorf("failed to insert imported calls: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, queryGetImportDuplicates)
+		if err != nil {
+			return fmt.Errorf("failed to get duplicate calls: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var dup entity.ImportDuplicate
+			if err := rows.Scan(&dup.Row, &dup.CallID); err != nil {
+				return err
+			}
+			res.Duplicates = append(res.Duplicates, dup)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		res.Imported = n - int64(len(res.Duplicates))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func markImportDuplicates(ctx context.Context, tx pgx.Tx, duplicateWithin time.Duration) error {
+	if _, err := tx.Exec(ctx, queryIndexImportTable); err != nil {
+		return fmt.Errorf("failed to index import table: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryLockImportedPhones); err != nil {
+		return fmt.Errorf("failed to lock phone numbers: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryMarkDuplicateCalls, duplicateWithin.Seconds()); err != nil {
+		return fmt.Errorf("failed to check duplicate calls: %w", err)
+	}
+	if _, err := tx.Exec(ctx, queryMarkRepeatedRows, duplicateWithin.Seconds()); err != nil {
+		return fmt.Errorf("failed to check repeated rows: %w", err)
+	}
+	return nil
+}
+
+// callCopySource adapts a call source to pgx.CopyFromSource.
+type callCopySource struct {
+	src entity.CallSource
+}
+
+func (s callCopySource) Next() bool {
+	return s.src.Next()
+}
+
+func (s callCopySource) Values() ([]any, error) {
+	call := s.src.Call()
+	var createdAt any
+	if !call.CreatedAt.IsZero() {
+		createdAt = call.CreatedAt
+	}
+	return []any{
+		s.src.Row(),
+		call.ClientName,
+		call.PhoneNumber,
+		call.Description,
+		call.Status,
+		createdAt,
+		call.UserID,
+		call.OrgID,
+		call.Priority,
+		call.DueIn.Seconds(),
+		call.PhoneE164,
+	}, nil
+}
+
+func (s callCopySource) Err() error {
+	return s.src.Err()
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrDuplicateNotFound = errors.New("duplicate call not found")
+	ErrDuplicateItem     = errors.New("call repeats an earlier item")
+	ErrMergeOtherCaller  = errors.New("duplicate call has a different caller")
+	ErrMergeOtherOrg     = errors.New("duplicate call belongs to a different organization")
+)
+
+// DuplicateCallError reports that a new call repeats the open call CallID.
+type DuplicateCallError struct {
+	CallID int64
+}
+
+func (e *DuplicateCallError) Error() string {
+	return fmt.Sprintf("duplicate of call %d", e.CallID)
+}
+
+const (
+	// queryLockPhone serializes the creation of calls with the same number
+	// until the end of the transaction, so that two requests cannot both miss
+	// each other's call.
+	queryLockPhone          = `SELECT pg_advisory_xact_lock(hashtext($1))`
+	queryLockPhones         = `SELECT pg_advisory_xact_lock(hashtext(phone)) FROM (SELECT DISTINCT phone FROM unnest($1::text[]) AS phone ORDER BY phone) AS phones`
+	queryFindDuplicateCall  = `SELECT id FROM calls WHERE phone_e164 = $1 AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $4 AND user_id = $2)) AND deleted_at IS NULL AND status NOT IN ('resolved', 'closed') AND created_at >= CURRENT_TIMESTAMP - make_interval(secs => $3) ORDER BY created_at DESC, id DESC LIMIT 1`
+	queryLockDuplicateCalls = `SELECT count(*) FROM (SELECT 1 FROM calls WHERE id = ANY($1) AND (user_id = $2 OR assignee_id = $2 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $3 AND user_id = $2)) AND deleted_at IS NULL FOR UPDATE) AS locked`
+	queryCompareMergedCalls = `SELECT COALESCE(bool_or(d.org_id IS DISTINCT FROM c.org_id), false), COALESCE(bool_or(d.phone_e164 IS DISTINCT FROM c.phone_e164 OR d.client_id IS DISTINCT FROM c.client_id), false) FROM calls AS d JOIN calls AS c ON c.id = $1 WHERE d.id = ANY($2)`
+	queryMoveCallEvents     = `UPDATE call_events SET call_id = $1 WHERE call_id = ANY($2)`
+	queryMoveCallComments   = `UPDATE call_comments SET call_id = $1 WHERE call_id = ANY($2)`
+	queryMoveAttachments    = `UPDATE call_attachments SET call_id = $1 WHERE call_id = ANY($2)`
+	queryMoveCallTags       = `INSERT INTO call_tags (call_id, tag_id) SELECT DISTINCT $1::bigint, tag_id FROM call_tags WHERE call_id = ANY($2) ON CONFLICT DO NOTHING`
+	queryDeleteMergedCalls  = `UPDATE calls SET deleted_at = CURRENT_TIMESTAMP, merged_into = $1, version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE id = ANY($2)`
+	queryTouchCall          = `UPDATE calls SET version = version + 1, updated_at = CURRENT_TIMESTAMP WHERE id = $1 RETURNING ` + callColumns
+)
+
+func checkDuplicateCall(ctx context.Context, tx pgx.Tx, call entity.Call) error {
+	if _, err := tx.Exec(ctx, queryLockPhone, call.PhoneE164); err != nil {
+		return fmt.Errorf("failed to lock phone number: %w", err)
+	}
+
+	var id int64
+	err := tx.QueryRow(ctx, queryFindDuplicateCall, call.PhoneE164, call.UserID, call.DuplicateWithin.Seconds(), call.OrgID).Scan(&id)
+	switch {
+	case err == nil:
+		return &DuplicateCallError{CallID: id}
+	case postgres.IsNotFoundError(err):
+		return nil
+	}
+	return fmt.Errorf("failed to check duplicate calls: %w", err)
+}
+
+// MergeCalls moves the history, comments, attachments and tags of the
+// duplicates to the surviving call, moves the duplicates to the trash with
+// merged_into pointing at it and records a merged event per duplicate, along
+// with a deleted message per duplicate in the outbox. All calls must be active
+// and visible to the user, and the duplicates must share the caller and the
+// organization of the surviving call.
+func (r *CallsRepo) MergeCalls(ctx context.Context, m entity.CallMerge) (*entity.CallResponse, error) {
+	var call entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lockUserCall(ctx, tx, m.CallID, m.UserID, m.OrgID); err != nil {
+			return err
+		}
+
+		var locked int
+		if err := tx.QueryRow(ctx, queryLockDuplicateCalls, m.DuplicateIDs, m.UserID, m.OrgID).Scan(&locked); err != nil {
+			return fmt.Errorf("failed to lock duplicate calls: %w", err)
+		}
+		if locked != len(m.DuplicateIDs) {
+			return ErrDuplicateNotFound
+		}
+
+		var otherOrg, otherCaller bool
+		if err := tx.QueryRow(ctx, queryCompareMergedCalls, m.CallID, m.DuplicateIDs).Scan(&otherOrg, &otherCaller); err != nil {
+			return fmt.Errorf("failed to compare duplicate calls: %w", err)
+		}
+		switch {
+		case otherOrg:
+			return ErrMergeOtherOrg
+		case otherCaller:
+			return ErrMergeOtherCaller
+		}
+
+		if _, err := tx.Exec(ctx, queryMoveCallEvents, m.CallID, m.DuplicateIDs); err != nil {
+			return fmt.Errorf("failed to move call events: %w", err)
+		}
+		if _, err := tx.Exec(ctx, queryMoveCallComments, m.CallID, m.DuplicateIDs); err != nil {
+			return fmt.Errorf("failed to move comments: %w", err)
+		}
+		if _, err := tx.Exec(ctx, queryMoveAttachments, m.CallID, m.DuplicateIDs); err != nil {
+			return fmt.Errorf("failed to move attachments: %w", err)
+		}
+		if _, err := tx.Exec(ctx, queryMoveCallTags, m.CallID, m.DuplicateIDs); err != nil {
+			return fmt.Errorf("failed to move tags: %w", err)
+		}
+		deleted := make([]entity.CallEvent, len(m.DuplicateIDs))
+		for i, id := range m.DuplicateIDs {
+			deleted[i] = entity.CallEvent{CallID: id, UserID: m.UserID, Type: entity.EventDeleted}
+		}
+		if err := writeOutbox(ctx, tx, deleted); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, queryDeleteMergedCalls, m.CallID, m.DuplicateIDs); err != nil {
+			return fmt.Errorf("failed to delete merged calls: %w", err)
+		}
+
+		events := make([]entity.CallEvent, len(m.DuplicateIDs))
+		for i, id := range m.DuplicateIDs {
+			merged := strconv.FormatInt(id, 10)
+			events[i] = entity.CallEvent{CallID: m.CallID, UserID: m.UserID, Type: entity.EventMerged, Field: "call", OldValue: &merged}
+		}
+		if err := recordEvents(ctx, tx, events); err != nil {
+			return err
+		}
+
+		return scanCall(tx.QueryRow(ctx, queryTouchCall, m.CallID), &call)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrOrgNotFound        = errors.New("organization not found")
+	ErrNotOrgOwner        = errors.New("user is not the owner of the organization")
+	ErrAlreadyMember      = errors.New("user is already a member or invited")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrMemberNotFound     = errors.New("member not found")
+	ErrOwnerCannotLeave   = errors.New("owner cannot leave the organization")
+)
+
+// userOrgs joins the organizations with the memberships of their users.
+const userOrgs = `SELECT o.id, o.name, m.role, o.created_at FROM memberships m JOIN organizations o ON o.id = m.org_id`
+
+const invitationColumns = `i.id, i.org_id, o.name, i.user_id, COALESCE(i.invited_by, 0), i.created_at`
+
+const (
+	queryCreateOrg        = `WITH org AS (INSERT INTO organizations (name, created_by) VALUES ($1, $2) RETURNING id, name, created_at), owner AS (INSERT INTO memberships (org_id, user_id, role) SELECT id, $2, $3 FROM org) SELECT id, name, $3, created_at FROM org`
+	queryGetUserOrgs      = userOrgs + ` WHERE m.user_id = $1 ORDER BY lower(o.name), o.id`
+	queryGetUserOrg       = userOrgs + ` WHERE m.org_id = $1 AND m.user_id = $2`
+	queryGetMemberRole    = `SELECT role FROM memberships WHERE org_id = $1 AND user_id = $2`
+	queryLockMemberRole   = queryGetMemberRole + ` FOR UPDATE`
+	queryGetOrgMembers    = `SELECT user_id, role, created_at FROM memberships WHERE org_id = $1 AND EXISTS (SELECT 1 FROM memberships WHERE org_id = $1 AND user_id = $2) ORDER BY created_at, user_id`
+	queryAddMember        = `INSERT INTO memberships (org_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	queryRemoveMember     = `DELETE FROM memberships WHERE org_id = $1 AND user_id = $2`
+	queryClearDefaultOrg  = `UPDATE users SET org_id = NULL WHERE id = $1 AND org_id = $2`
+	querySaveInvitation   = `INSERT INTO invitations (org_id, user_id, invited_by) VALUES ($1, $2, $3) RETURNING id, org_id, (SELECT name FROM organizations WHERE id = $1), user_id, invited_by, created_at`
+	queryGetInvitations   = `SELECT ` + invitationColumns + ` FROM invitations i JOIN organizations o ON o.id = i.org_id WHERE i.user_id = $1 ORDER BY i.id`
+	queryTakeInvitation   = `DELETE FROM invitations WHERE id = $1 AND user_id = $2 RETURNING org_id`
+	queryDeleteInvitation = `DELETE FROM invitations i WHERE i.id = $1 AND (i.user_id = $2 OR EXISTS (SELECT 1 FROM memberships m WHERE m.org_id = i.org_id AND m.user_id = $2 AND m.role = $3))`
+)
+
+func scanOrg(row pgx.Row, org *entity.Organization) error {
+	return row.Scan(&org.ID, &org.Name, &org.Role, &org.CreatedAt)
+}
+
+func scanInvitation(row pgx.Row, inv *entity.Invitation) error {
+	return row.Scan(&inv.ID, &inv.OrgID, &inv.OrgName, &inv.UserID, &inv.InvitedBy, &inv.CreatedAt)
+}
+
+// CreateOrg creates an organization owned by the user.
+func (r *CallsRepo) CreateOrg(ctx context.Context, name string, userID int64) (*entity.Organization, error) {
+	var org entity.Organization
+
+	if err := scanOrg(r.Pool.QueryRow(ctx, queryCreateOrg, name, userID, entity.OrgOwner), &org); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return &org, nil
+}
+
+// GetUserOrgs returns the organizations the user is a member of ordered by
+// name.
+func (r *CallsRepo) GetUserOrgs(ctx context.Context, userID int64) ([]entity.Organization, error) {
+	rows, err := r.Pool.Query(ctx, queryGetUserOrgs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizations: %w", err)
+	}
+	defer rows.Close()
+
+	orgs := []entity.Organization{}
+	for rows.Next() {
+		var org entity.Organization
+		if err := scanOrg(rows, &org); err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, org)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// GetUserOrg returns an organization the user is a member of.
+func (r *CallsRepo) GetUserOrg(ctx context.Context, orgID, userID int64) (*entity.Organization, error) {
+	var org entity.Organization
+
+	if err := scanOrg(r.Pool.QueryRow(ctx, queryGetUserOrg, orgID, userID), &org); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, ErrOrgNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	return &org, nil
+}
+
+// GetOrgMembers returns the members of an organization the user is a member
+// of, in the order they joined.
+func (r *CallsRepo) GetOrgMembers(ctx context.Context, orgID, userID int64) ([]entity.Member, error) {
+	rows, err := r.Pool.Query(ctx, queryGetOrgMembers, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []entity.Member{}
+	for rows.Next() {
+		var m entity.Member
+		if err := rows.Scan(&m.UserID, &m.Role, &m.JoinedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// An organization always has its owner as a member, so no rows means
+	// the user is not one.
+	if len(members) == 0 {
+		return nil, ErrOrgNotFound
+	}
+
+	return members, nil
+}
+
+// SaveInvitation invites inv.UserID to an organization on behalf of its
+// owner inv.InvitedBy.
+func (r *CallsRepo) SaveInvitation(ctx context.Context, inv entity.Invitation) (*entity.Invitation, error) {
+	var saved entity.Invitation
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		role, err := memberRole(ctx, tx, queryGetMemberRole, inv.OrgID, inv.InvitedBy)
+		if err != nil {
+			return err
+		}
+		if role != entity.OrgOwner {
+			return ErrNotOrgOwner
+		}
+
+		if _, err := memberRole(ctx, tx, queryGetMemberRole, inv.OrgID, inv.UserID); err == nil {
+			return ErrAlreadyMember
+		} else if !errors.Is(err, ErrOrgNotFound) {
+			return err
+		}
+
+		err = scanInvitation(tx.QueryRow(ctx, querySaveInvitation, inv.OrgID, inv.UserID, inv.InvitedBy), &saved)
+		if err != nil {
+			if postgres.IsUniqueViolation(err) {
+				return ErrAlreadyMember
+			}
+			return fmt.Errorf("failed to save invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// GetInvitations returns the pending invitations of the user, oldest first.
+func (r *CallsRepo) GetInvitations(ctx context.Context, userID int64) ([]entity.Invitation, error) {
+	rows, err := r.Pool.Query(ctx, queryGetInvitations, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	defer rows.Close()
+
+	invitations := []entity.Invitation{}
+	for rows.Next() {
+		var inv entity.Invitation
+		if err := scanInvitation(rows, &inv); err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, inv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// AcceptInvitation makes the user a member of the organization they were
+// invited to and returns it.
+func (r *CallsRepo) AcceptInvitation(ctx context.Context, invitationID, userID int64) (*entity.Organization, error) {
+	var org entity.Organization
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		var orgID int64
+		if err := tx.QueryRow(ctx, queryTakeInvitation, invitationID, userID).Scan(&orgID); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrInvitationNotFound
+			}
+			return fmt.Errorf("failed to take invitation: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, queryAddMember, orgID, userID, entity.OrgMember); err != nil {
+			return fmt.Errorf("failed to add member: %w", err)
+		}
+
+		return scanOrg(tx.QueryRow(ctx, queryGetUserOrg, orgID, userID), &org)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// DeleteInvitation declines an invitation of the user, or revokes one sent
+// by an organization the user owns.
+func (r *CallsRepo) DeleteInvitation(ctx context.Context, invitationID, userID int64) error {
+	cmdTag, err := r.Pool.Exec(ctx, queryDeleteInvitation, invitationID, userID, entity.OrgOwner)
+	if err != nil {
+		return fmt.Errorf("failed to delete invitation: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+// RemoveMember removes a member from an organization. The owner may remove
+// any other member, and a member may leave; the owner cannot. A member whose
+// logins started in the organization starts in none. The calls of the
+// organization, those the member created among them, stay with it.
+func (r *CallsRepo) RemoveMember(ctx context.Context, rm entity.MemberRemoval) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		role, err := memberRole(ctx, tx, queryGetMemberRole, rm.OrgID, rm.UserID)
+		if err != nil {
+			return err
+		}
+		if rm.MemberID != rm.UserID && role != entity.OrgOwner {
+			return ErrNotOrgOwner
+		}
+
+		removed, err := memberRole(ctx, tx, queryLockMemberRole, rm.OrgID, rm.MemberID)
+		if err != nil {
+			if errors.Is(err, ErrOrgNotFound) {
+				return ErrMemberNotFound
+			}
+			return err
+		}
+		if removed == entity.OrgOwner {
+			return ErrOwnerCannotLeave
+		}
+
+		if _, err := tx.Exec(ctx, queryRemoveMember, rm.OrgID, rm.MemberID); err != nil {
+			return fmt.Errorf("failed to remove member: %w", err)
+		}
+		if _, err := tx.Exec(ctx, queryClearDefaultOrg, rm.MemberID, rm.OrgID); err != nil {
+			return fmt.Errorf("failed to clear default organization: %w", err)
+		}
+		return nil
+	})
+}
+
+// memberRole returns the role of the user in the organization, or
+// ErrOrgNotFound if the user is not a member.
+func memberRole(ctx context.Context, tx pgx.Tx, query string, orgID, userID int64) (string, error) {
+	var role string
+	if err := tx.QueryRow(ctx, query, orgID, userID).Scan(&role); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return "", ErrOrgNotFound
+		}
+		return "", fmt.Errorf("failed to get membership: %w", err)
+	}
+	return role, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// queryLockOutbox makes the relay runs take turns, since concurrent ones
+	// could publish the messages of a call out of order.
+	queryLockOutbox    = `SELECT pg_try_advisory_xact_lock(hashtext('outbox'))`
+	queryGetOutbox     = `SELECT id, call_id, event_type, payload, created_at FROM outbox ORDER BY id LIMIT $1`
+	queryDeleteOutbox  = `DELETE FROM outbox WHERE id = ANY($1)`
+	queryGetCallOwners = `SELECT id, user_id, assignee_id, COALESCE(org_id, 0) FROM calls WHERE id = ANY($1)`
+)
+
+var outboxColumns = []string{"call_id", "event_type", "payload"}
+
+// writeOutbox puts a message into the outbox for every change described by
+// events, which are grouped by call, type and user as they follow each other.
+func writeOutbox(ctx context.Context, tx pgx.Tx, events []entity.CallEvent) error {
+	changes := callChanges(events, time.Now().UTC())
+	if err := fillCallOwners(ctx, tx, changes); err != nil {
+		return err
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"outbox"}, outboxColumns,
+		pgx.CopyFromSlice(len(changes), func(i int) ([]any, error) {
+			payload, err := json.Marshal(changes[i])
+			if err != nil {
+				return nil, err
+			}
+			return []any{changes[i].CallID, changes[i].Event, payload}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+
+	return nil
+}
+
+// fillCallOwners sets the creator, assignee and organization of the calls of
+// changes as they are now.
+func fillCallOwners(ctx context.Context, tx pgx.Tx, changes []entity.CallChange) error {
+	ids := make([]int64, len(changes))
+	for i := range changes {
+		ids[i] = changes[i].CallID
+	}
+
+	rows, err := tx.Query(ctx, queryGetCallOwners, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get call owners: %w", err)
+	}
+	defer rows.Close()
+
+	owners := make(map[int64]entity.CallChange, len(ids))
+	for rows.Next() {
+		var (
+			id    int64
+			owner entity.CallChange
+		)
+		if err := rows.Scan(&id, &owner.OwnerID, &owner.AssigneeID, &owner.OrgID); err != nil {
+			return err
+		}
+		owners[id] = owner
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to get call owners: %w", err)
+	}
+
+	for i := range changes {
+		owner := owners[changes[i].CallID]
+		changes[i].OwnerID, changes[i].AssigneeID, changes[i].OrgID = owner.OwnerID, owner.AssigneeID, owner.OrgID
+	}
+	return nil
+}
+
+func callChanges(events []entity.CallEvent, now time.Time) []entity.CallChange {
+	var changes []entity.CallChange
+	for _, e := range events {
+		n := len(changes)
+		if n == 0 || changes[n-1].CallID != e.CallID || changes[n-1].Event != e.Type || changes[n-1].UserID != e.UserID {
+			changes = append(changes, entity.CallChange{
+				Event:      e.Type,
+				CallID:     e.CallID,
+				UserID:     e.UserID,
+				OccurredAt: now,
+			})
+			n++
+		}
+		if e.Field != "" {
+			changes[n-1].Changes = append(changes[n-1].Changes, entity.FieldChange{
+				Field:    e.Field,
+				OldValue: e.OldValue,
+				NewValue: e.NewValue,
+			})
+		}
+	}
+	return changes
+}
+
+// PublishOutbox passes up to limit outbox messages to publish in the order
+// they were written and removes the published ones. After a message of a
+// call fails, the later messages of that call are left for the next run, so
+// that the messages of every call are published in order. A message may be
+// published again if removing it fails. Runs take turns: while one is
+// publishing, the others return at once.
+func (r *CallsRepo) PublishOutbox(ctx context.Context, limit int, publish func(entity.OutboxMessage) error) (int, error) {
+	var (
+		published []int64
+		errs      []error
+	)
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		var locked bool
+		if err := tx.QueryRow(ctx, queryLockOutbox).Scan(&locked); err != nil {
+			return fmt.Errorf("failed to lock outbox: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		messages, err := getOutbox(ctx, tx, limit)
+		if err != nil {
+			return err
+		}
+
+		held := make(map[int64]bool)
+		for _, m := range messages {
+			if held[m.CallID] {
+				continue
+			}
+			if err := publish(m); err != nil {
+				held[m.CallID] = true
+				errs = append(errs, fmt.Errorf("message %d: %w", m.ID, err))
+				continue
+			}
+			published = append(published, m.ID)
+		}
+
+		if len(published) == 0 {
+			return nil
+		}
+
+		if _, err := tx.Exec(ctx, queryDeleteOutbox, published); err != nil {
+			return fmt.Errorf("failed to remove published messages: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(published), errors.Join(errs...)
+}
+
+func getOutbox(ctx context.Context, tx pgx.Tx, limit int) ([]entity.OutboxMessage, error) {
+	rows, err := tx.Query(ctx, queryGetOutbox, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []entity.OutboxMessage
+	for rows.Next() {
+		var m entity.OutboxMessage
+		if err := rows.Scan(&m.ID, &m.CallID, &m.Event, &m.Payload, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
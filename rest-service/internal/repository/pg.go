@@ -2,17 +2,79 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"calls-service/pkg/postgres"
 	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
 )
 
 type Repository interface {
-	SaveCall(context.Context, entity.Call) error
-	GetUserCalls(context.Context, int64) ([]entity.CallResponse, error)
-	GetUserCallByID(context.Context, int64, int64) (*entity.CallResponse, error)
-	UpdateCallStatus(context.Context, int64, int64, string) error
-	DeleteCall(context.Context, int64, int64) error
+	SaveCall(context.Context, entity.Call) (int64, error)
+	GetUserCalls(context.Context, entity.CallsQuery) ([]entity.CallResponse, error)
+	ExportCalls(context.Context, entity.CallsQuery, func(entity.CallResponse) error) error
+	SearchCalls(context.Context, entity.CallsSearchQuery) ([]entity.CallSearchResult, error)
+	GetUserCallByID(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	UpdateCall(context.Context, entity.CallUpdate) (*entity.CallResponse, error)
+	UpdateCallStatus(context.Context, entity.StatusChange) error
+	AssignCall(context.Context, entity.Assignment) (*entity.CallResponse, error)
+	DeleteCall(context.Context, int64, int64, int64) error
+	RestoreCall(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	PurgeCalls(context.Context, time.Duration) (int64, []string, error)
+	GetUserCallsByIDs(context.Context, int64, int64, []int64) ([]entity.CallResponse, error)
+	SaveCalls(context.Context, []entity.Call, bool) ([]int64, []error, error)
+	ImportCalls(context.Context, entity.CallSource, time.Duration) (*entity.ImportResult, error)
+	UpdateCallsStatus(context.Context, []entity.StatusChange, bool) ([]error, error)
+	DeleteCalls(context.Context, int64, int64, []int64, bool) ([]error, error)
+	MarkSLA(context.Context, time.Duration) ([]entity.SLAFlag, error)
+	ScheduleCallback(context.Context, entity.Callback) (*entity.CallResponse, error)
+	SnoozeCallback(context.Context, entity.CallbackSnooze) (*entity.CallResponse, error)
+	CancelCallback(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	GetUpcomingCallbacks(context.Context, entity.CallbacksQuery) ([]entity.CallResponse, error)
+	MarkCallbacksDue(context.Context) ([]entity.CallbackDue, error)
+	GetCallHistory(context.Context, int64) ([]entity.CallEvent, error)
+	SaveComment(context.Context, entity.Comment) (*entity.Comment, error)
+	GetComments(context.Context, int64, *bool) ([]entity.Comment, error)
+	GetCallsComments(context.Context, int64, int64, []int64) ([]entity.Comment, error)
+	UpdateComment(context.Context, entity.CommentUpdate) (*entity.Comment, error)
+	DeleteComment(context.Context, int64, int64, int64) error
+	SaveTag(context.Context, entity.Tag) (*entity.Tag, error)
+	GetTags(context.Context, int64) ([]entity.Tag, error)
+	RenameTag(context.Context, entity.Tag) (*entity.Tag, error)
+	DeleteTag(context.Context, int64, int64) error
+	AttachTag(context.Context, entity.TagChange) (*entity.CallResponse, error)
+	DetachTag(context.Context, entity.TagChange) (*entity.CallResponse, error)
+	GetClients(context.Context, entity.ClientsQuery) ([]entity.Client, error)
+	GetClient(context.Context, int64, int64, int64) (*entity.Client, error)
+	GetClientsByIDs(context.Context, int64, int64, []int64) ([]entity.Client, error)
+	MergeCalls(context.Context, entity.CallMerge) (*entity.CallResponse, error)
+	SaveAttachment(context.Context, entity.Attachment) (*entity.Attachment, error)
+	GetAttachments(context.Context, int64) ([]entity.Attachment, error)
+	GetAttachment(context.Context, int64, int64) (*entity.Attachment, error)
+	DeleteAttachment(context.Context, int64, int64, int64) (string, error)
+	GetCallStats(context.Context, entity.StatsQuery) (*entity.CallStats, error)
+	SaveWebhook(context.Context, entity.Webhook) (*entity.Webhook, error)
+	GetWebhooks(context.Context, int64) ([]entity.Webhook, error)
+	DeleteWebhook(context.Context, int64, int64) error
+	GetWebhookDeliveries(context.Context, entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error)
+	RedeliverWebhook(context.Context, int64, int64, int64) (*entity.WebhookDelivery, error)
+	QueueWebhooks(context.Context, int) (int, error)
+	ClaimWebhookDeliveries(context.Context, int, time.Duration) ([]entity.WebhookDelivery, error)
+	SaveDeliveryState(context.Context, entity.WebhookDelivery) error
+	PublishOutbox(context.Context, int, func(entity.OutboxMessage) error) (int, error)
+	ListenCallChanges(context.Context, func(entity.CallNotification)) error
+	CreateOrg(context.Context, string, int64) (*entity.Organization, error)
+	GetUserOrgs(context.Context, int64) ([]entity.Organization, error)
+	GetUserOrg(context.Context, int64, int64) (*entity.Organization, error)
+	GetOrgMembers(context.Context, int64, int64) ([]entity.Member, error)
+	SaveInvitation(context.Context, entity.Invitation) (*entity.Invitation, error)
+	GetInvitations(context.Context, int64) ([]entity.Invitation, error)
+	AcceptInvitation(context.Context, int64, int64) (*entity.Organization, error)
+	DeleteInvitation(context.Context, int64, int64) error
+	RemoveMember(context.Context, entity.MemberRemoval) error
 }
 
 type CallsRepo struct {
@@ -22,3 +84,27 @@ type CallsRepo struct {
 func New(pg *postgres.Postgres) *CallsRepo {
 	return &CallsRepo{pg}
 }
+
+// inTx runs fn in a transaction that is committed when fn succeeds and rolled back otherwise.
+func (r *CallsRepo) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && !postgres.IsTxClosed(err) {
+			log.Error().Err(err).Msg("failed to rollback transaction")
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
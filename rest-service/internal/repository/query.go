@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+)
+
+// queryBuilder collects WHERE conditions and their arguments, replacing
+// every "?" in a condition with the next positional placeholder.
+type queryBuilder struct {
+	conds []string
+	args  []any
+}
+
+func (b *queryBuilder) where(cond string, args ...any) {
+	var sb strings.Builder
+	for _, r := range cond {
+		if r == '?' && len(args) > 0 {
+			sb.WriteString(b.arg(args[0]))
+			args = args[1:]
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	b.conds = append(b.conds, sb.String())
+}
+
+func (b *queryBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// containsPattern returns a LIKE pattern matching s anywhere, with the
+// wildcards and backslashes in s escaped for ESCAPE '\'.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (b *queryBuilder) whereClause() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conds, " AND ")
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+const headlineOptions = `StartSel=<mark>, StopSel=</mark>, MaxFragments=2, HighlightAll=false`
+
+// The text is HTML-escaped before highlighting, so that the only markup in a
+// headline is the one ts_headline adds.
+const (
+	escapeHTMLStart = `replace(replace(replace(replace(replace(`
+	escapeHTMLEnd   = `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+)
+
+// querySearchCalls matches the russian full-text vector and falls back to
+// trigram similarity on the client name and substring match on the phone.
+const querySearchCalls = `SELECT ` + callColumns + `,
+	ts_rank(search_vector, query) + similarity(client_name, $2) AS rank,
+	ts_headline('russian', ` + escapeHTMLStart + `client_name` + escapeHTMLEnd + `, query, '` + headlineOptions + `'),
+	ts_headline('russian', ` + escapeHTMLStart + `description` + escapeHTMLEnd + `, query, '` + headlineOptions + `')
+FROM calls, websearch_to_tsquery('russian', $2) AS query
+WHERE (user_id = $1 OR assignee_id = $1 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $5 AND user_id = $1))
+	AND deleted_at IS NULL
+	AND (search_vector @@ query OR client_name % $2 OR phone_number ILIKE $4 ESCAPE '\')
+ORDER BY rank DESC, id DESC
+LIMIT $3`
+
+func (r *CallsRepo) SearchCalls(ctx context.Context, q entity.CallsSearchQuery) ([]entity.CallSearchResult, error) {
+	rows, err := r.Pool.Query(ctx, querySearchCalls, q.UserID, q.Text, q.Limit, containsPattern(q.Text), q.OrgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search calls: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]entity.CallSearchResult, 0, q.Limit)
+	for rows.Next() {
+		var res entity.CallSearchResult
+		dest := append(callFields(&res.CallResponse),
+			&res.Rank,
+			&res.Highlight.ClientName,
+			&res.Highlight.Description,
+		)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
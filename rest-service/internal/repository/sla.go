@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// queryMarkSLA moves open calls whose deadline is within warnBefore to
+// at_risk and overdue ones to breached and puts an sla_changed message for
+// each of them into the outbox. The version is bumped, as sla_status is part
+// of the call. Calls already in the right state are skipped, so every
+// returned row is a new flag.
+const queryMarkSLA = `WITH due AS (
+	SELECT id, sla_status AS old_state, CASE WHEN due_at <= CURRENT_TIMESTAMP THEN 'breached' ELSE 'at_risk' END AS state
+	FROM calls
+	WHERE due_at IS NOT NULL
+		AND deleted_at IS NULL
+		AND status NOT IN ('resolved', 'closed')
+		AND due_at <= CURRENT_TIMESTAMP + make_interval(secs => $1)
+), flagged AS (
+	UPDATE calls SET sla_status = due.state, version = version + 1
+	FROM due
+	WHERE calls.id = due.id AND calls.sla_status <> due.state
+	RETURNING calls.id, calls.user_id, calls.assignee_id, calls.org_id, calls.sla_status, calls.due_at, due.old_state
+), queued AS (
+	INSERT INTO outbox (call_id, event_type, payload)
+	SELECT id, $2, jsonb_build_object(
+		'event', $2::text,
+		'call_id', id,
+		'user_id', 0,
+		'owner_id', user_id,
+		'assignee_id', assignee_id,
+		'org_id', COALESCE(org_id, 0),
+		'changes', jsonb_build_array(jsonb_build_object('field', 'sla_status', 'old_value', old_state, 'new_value', sla_status)),
+		'occurred_at', CURRENT_TIMESTAMP
+	)
+	FROM flagged
+	ORDER BY id
+)
+SELECT id, user_id, assignee_id, sla_status, due_at FROM flagged`
+
+func (r *CallsRepo) MarkSLA(ctx context.Context, warnBefore time.Duration) ([]entity.SLAFlag, error) {
+	rows, err := r.Pool.Query(ctx, queryMarkSLA, warnBefore.Seconds(), entity.EventSLAChanged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark sla: %w", err)
+	}
+	defer rows.Close()
+
+	var flags []entity.SLAFlag
+	for rows.Next() {
+		var f entity.SLAFlag
+		if err := rows.Scan(&f.CallID, &f.UserID, &f.AssigneeID, &f.SLAStatus, &f.DueAt); err != nil {
+			return nil, err
+		}
+		flags = append(flags, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flags, nil
+}
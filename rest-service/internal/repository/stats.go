@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// statsScope matches the active calls visible to user $1 in organization $2,
+// or with $3 set all active calls of the organization, provided the user is
+// still a member of it.
+const statsScope = `deleted_at IS NULL AND CASE WHEN $3::bool
+	THEN org_id = $2 AND EXISTS (SELECT 1 FROM memberships WHERE org_id = $2 AND user_id = $1)
+	ELSE user_id = $1 OR assignee_id = $1 OR org_id IN (SELECT org_id FROM memberships WHERE org_id = $2 AND user_id = $1)
+END`
+
+const (
+	queryStatusCounts = `SELECT s.code, s.label, count(c.id) FROM call_statuses s LEFT JOIN (SELECT id, status FROM calls WHERE ` + statsScope + `) c ON c.status = s.code GROUP BY s.code ORDER BY s.position`
+
+	// queryDailyCounts counts the calls created and closed on each day from $4
+	// to $5 inclusive, including days without any.
+	queryDailyCounts = `WITH days AS (
+	SELECT generate_series($4::date, $5::date, interval '1 day')::date AS day
+), created AS (
+	SELECT created_at::date AS day, count(*) AS n FROM calls
+	WHERE ` + statsScope + ` AND created_at >= $4::date AND created_at < $5::date + 1
+	GROUP BY 1
+), closed AS (
+	SELECT closed_at::date AS day, count(*) AS n FROM calls
+	WHERE ` + statsScope + ` AND closed_at >= $4::date AND closed_at < $5::date + 1
+	GROUP BY 1
+)
+SELECT days.day, COALESCE(created.n, 0), COALESCE(closed.n, 0)
+FROM days LEFT JOIN created USING (day) LEFT JOIN closed USING (day)
+ORDER BY days.day`
+
+	queryResolutionStats = `SELECT count(*), avg(s), percentile_cont(0.5) WITHIN GROUP (ORDER BY s), percentile_cont(0.9) WITHIN GROUP (ORDER BY s), percentile_cont(0.95) WITHIN GROUP (ORDER BY s)
+FROM (
+	SELECT extract(epoch FROM closed_at - created_at)::float8 AS s FROM calls
+	WHERE ` + statsScope + ` AND closed_at >= $4::date AND closed_at < $5::date + 1
+) resolved`
+)
+
+const statsDateLayout = "2006-01-02"
+
+// GetCallStats computes the statistics of q. The scope is left to the caller.
+func (r *CallsRepo) GetCallStats(ctx context.Context, q entity.StatsQuery) (*entity.CallStats, error) {
+	from, to := q.From.Format(statsDateLayout), q.To.Format(statsDateLayout)
+
+	stats := entity.CallStats{From: from, To: to, ByStatus: []entity.StatusCount{}, Daily: []entity.DailyCount{}}
+
+	rows, err := r.Pool.Query(ctx, queryStatusCounts, q.UserID, q.OrgID, q.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count calls by status: %w", err)
+	}
+	for rows.Next() {
+		var sc entity.StatusCount
+		if err := rows.Scan(&sc.Status, &sc.Label, &sc.Count); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		stats.ByStatus = append(stats.ByStatus, sc)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count calls by status: %w", err)
+	}
+
+	rows, err = r.Pool.Query(ctx, queryDailyCounts, q.UserID, q.OrgID, q.Team, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count calls by day: %w", err)
+	}
+	for rows.Next() {
+		var (
+			day time.Time
+			dc  entity.DailyCount
+		)
+		if err := rows.Scan(&day, &dc.Created, &dc.Closed); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		dc.Date = day.Format(statsDateLayout)
+		stats.Daily = append(stats.Daily, dc)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to count calls by day: %w", err)
+	}
+
+	res := &stats.Resolution
+	err = r.Pool.QueryRow(ctx, queryResolutionStats, q.UserID, q.OrgID, q.Team, from, to).Scan(&res.Closed, &res.AvgSeconds, &res.P50Seconds, &res.P90Seconds, &res.P95Seconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute resolution time: %w", err)
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/rs/zerolog/log"
+)
+
+const queryListenCallChanges = `LISTEN call_changes`
+
+// ListenCallChanges passes the call changes announced by the database to fn
+// until ctx is done or the connection fails. Changes made while nobody is
+// listening are not passed on later.
+func (r *CallsRepo) ListenCallChanges(ctx context.Context, fn func(entity.CallNotification)) error {
+	pooled, err := r.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	// The connection keeps listening, so it must not go back to the pool.
+	conn := pooled.Hijack()
+	defer func() { _ = conn.Close(context.WithoutCancel(ctx)) }()
+
+	if _, err := conn.Exec(ctx, queryListenCallChanges); err != nil {
+		return fmt.Errorf("failed to listen for call changes: %w", err)
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for call changes: %w", err)
+		}
+
+		var cn entity.CallNotification
+		if err := json.Unmarshal([]byte(n.Payload), &cn); err != nil {
+			log.Error().Err(err).Str("payload", n.Payload).Msg("invalid call change notification")
+			continue
+		}
+		fn(cn)
+	}
+}
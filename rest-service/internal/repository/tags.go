@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagExists      = errors.New("tag already exists")
+	ErrTagNotAttached = errors.New("tag is not attached to the call")
+)
+
+const tagColumns = `id, user_id, name, created_at`
+
+const (
+	querySaveTag    = `INSERT INTO tags (user_id, name) VALUES ($1, $2) RETURNING ` + tagColumns
+	queryGetTags    = `SELECT ` + tagColumns + ` FROM tags WHERE user_id = $1 ORDER BY lower(name), id`
+	queryRenameTag  = `UPDATE tags SET name = $3 WHERE id = $1 AND user_id = $2 RETURNING ` + tagColumns
+	queryDeleteTag  = `DELETE FROM tags WHERE id = $1 AND user_id = $2`
+	queryUntagCalls = `INSERT INTO call_events (call_id, user_id, event_type, field, old_value) SELECT ct.call_id, $2, $3, 'tag', t.name FROM call_tags ct JOIN tags t ON t.id = ct.tag_id WHERE t.id = $1 AND t.user_id = $2 ORDER BY ct.call_id`
+	queryGetTagName = `SELECT name FROM tags WHERE id = $1 AND user_id = $2`
+	queryAttachTag  = `INSERT INTO call_tags (call_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	queryDetachTag  = `DELETE FROM call_tags ct USING tags t WHERE ct.call_id = $1 AND ct.tag_id = $2 AND t.id = ct.tag_id RETURNING t.name`
+)
+
+func scanTag(row pgx.Row, tag *entity.Tag) error {
+	return row.Scan(&tag.ID, &tag.UserID, &tag.Name, &tag.CreatedAt)
+}
+
+func (r *CallsRepo) SaveTag(ctx context.Context, tag entity.Tag) (*entity.Tag, error) {
+	var saved entity.Tag
+
+	if err := scanTag(r.Pool.QueryRow(ctx, querySaveTag, tag.UserID, tag.Name), &saved); err != nil {
+		if postgres.IsUniqueViolation(err) {
+			return nil, ErrTagExists
+		}
+		return nil, fmt.Errorf("failed to save tag: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// GetTags returns the tag catalogue of the user ordered by name.
+func (r *CallsRepo) GetTags(ctx context.Context, userID int64) ([]entity.Tag, error) {
+	rows, err := r.Pool.Query(ctx, queryGetTags, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []entity.Tag{}
+	for rows.Next() {
+		var tag entity.Tag
+		if err := scanTag(rows, &tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (r *CallsRepo) RenameTag(ctx context.Context, tag entity.Tag) (*entity.Tag, error) {
+	var renamed entity.Tag
+
+	if err := scanTag(r.Pool.QueryRow(ctx, queryRenameTag, tag.ID, tag.UserID, tag.Name), &renamed); err != nil {
+		switch {
+		case postgres.IsNotFoundError(err):
+			return nil, ErrTagNotFound
+		case postgres.IsUniqueViolation(err):
+			return nil, ErrTagExists
+		}
+		return nil, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	return &renamed, nil
+}
+
+// DeleteTag removes a tag from the catalogue of the user, detaching it from
+// every call. The detachment is recorded in the history of each call.
+func (r *CallsRepo) DeleteTag(ctx context.Context, tagID, userID int64) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, queryUntagCalls, tagID, userID, entity.EventUntagged); err != nil {
+			return fmt.Errorf("failed to record call events: %w", err)
+		}
+
+		cmdTag, err := tx.Exec(ctx, queryDeleteTag, tagID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete tag: %w", err)
+		}
+		if cmdTag.RowsAffected() == 0 {
+			return ErrTagNotFound
+		}
+
+		return nil
+	})
+}
+
+// AttachTag attaches a tag from the catalogue of the user to a call visible to
+// them and returns the call. Attaching a tag twice changes nothing.
+func (r *CallsRepo) AttachTag(ctx context.Context, ch entity.TagChange) (*entity.CallResponse, error) {
+	var call entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lockUserCall(ctx, tx, ch.CallID, ch.UserID, ch.OrgID); err != nil {
+			return err
+		}
+
+		var name string
+		if err := tx.QueryRow(ctx, queryGetTagName, ch.TagID, ch.UserID).Scan(&name); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrTagNotFound
+			}
+			return fmt.Errorf("failed to get tag: %w", err)
+		}
+
+		cmdTag, err := tx.Exec(ctx, queryAttachTag, ch.CallID, ch.TagID)
+		if err != nil {
+			return fmt.Errorf("failed to attach tag: %w", err)
+		}
+		if cmdTag.RowsAffected() > 0 {
+			e := entity.CallEvent{CallID: ch.CallID, UserID: ch.UserID, Type: entity.EventTagged, Field: "tag", NewValue: &name}
+			if err := recordEvents(ctx, tx, []entity.CallEvent{e}); err != nil {
+				return err
+			}
+		}
+
+		return scanCall(tx.QueryRow(ctx, queryGetUserCallByID, ch.CallID, ch.UserID, ch.OrgID), &call)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// DetachTag detaches a tag from a call visible to the user and returns the call.
+func (r *CallsRepo) DetachTag(ctx context.Context, ch entity.TagChange) (*entity.CallResponse, error) {
+	var call entity.CallResponse
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lockUserCall(ctx, tx, ch.CallID, ch.UserID, ch.OrgID); err != nil {
+			return err
+		}
+
+		var name string
+		if err := tx.QueryRow(ctx, queryDetachTag, ch.CallID, ch.TagID).Scan(&name); err != nil {
+			if postgres.IsNotFoundError(err) {
+				return ErrTagNotAttached
+			}
+			return fmt.Errorf("failed to detach tag: %w", err)
+		}
+
+		e := entity.CallEvent{CallID: ch.CallID, UserID: ch.UserID, Type: entity.EventUntagged, Field: "tag", OldValue: &name}
+		if err := recordEvents(ctx, tx, []entity.CallEvent{e}); err != nil {
+			return err
+		}
+
+		return scanCall(tx.QueryRow(ctx, queryGetUserCallByID, ch.CallID, ch.UserID, ch.OrgID), &call)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// lockUserCall locks a call visible to the user in the organization until the
+// end of tx.
+func lockUserCall(ctx context.Context, tx pgx.Tx, callID, userID, orgID int64) error {
+	var call entity.CallResponse
+	if err := scanCall(tx.QueryRow(ctx, queryLockUserCall, callID, userID, orgID), &call); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return ErrCallNotFound
+		}
+		return fmt.Errorf("failed to lock call: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/pkg/postgres"
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrWebhookNotFound  = errors.New("webhook not found")
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+const (
+	webhookColumns  = `id, user_id, url, events, team, COALESCE(org_id, 0), created_at`
+	deliveryColumns = `id, webhook_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, redelivery_of, created_at, delivered_at`
+)
+
+const (
+	querySaveWebhook       = `INSERT INTO webhooks (user_id, url, secret, events, team, org_id) SELECT $1, $2, $3, $4, $5, NULLIF($6, 0) WHERE $6 = 0 OR EXISTS (SELECT 1 FROM memberships WHERE org_id = $6 AND user_id = $1) RETURNING ` + webhookColumns
+	queryGetWebhooks       = `SELECT ` + webhookColumns + ` FROM webhooks WHERE user_id = $1 ORDER BY id`
+	queryWebhookExists     = `SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1 AND user_id = $2)`
+	queryDeleteWebhook     = `DELETE FROM webhooks WHERE id = $1 AND user_id = $2`
+	queryRedeliverWebhook  = `INSERT INTO webhook_deliveries (webhook_id, event_type, payload, redelivery_of) SELECT webhook_id, event_type, payload, id FROM webhook_deliveries WHERE id = $1 AND webhook_id = $2 RETURNING ` + deliveryColumns
+	querySaveDeliveryState = `UPDATE webhook_deliveries SET status = $2, next_attempt_at = $3, last_status_code = $4, last_error = $5, delivered_at = CASE WHEN $2 = 'delivered' THEN CURRENT_TIMESTAMP END WHERE id = $1`
+)
+
+const (
+	queryGetWebhookEvents    = `SELECT id, call_id, event_type, user_id, owner_id, assignee_id, org_id, occurred_at FROM webhook_events ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`
+	queryGetCallsByIDs       = `SELECT ` + callColumns + ` FROM calls WHERE id = ANY($1)`
+	queryDeleteWebhookEvents = `DELETE FROM webhook_events WHERE id = ANY($1)`
+)
+
+// queryEnqueueWebhooks queues a delivery of event $1 with payload $2 for every
+// webhook subscribed to it that may see a call created by $3, assigned to $4
+// and shared with organization $5: webhooks see the calls their owner created
+// or is assigned and those of the organizations their owner is a member of,
+// since webhooks outlive any session, and team webhooks those of their
+// organization as long as their owner is a member of it.
+const queryEnqueueWebhooks = `INSERT INTO webhook_deliveries (webhook_id, event_type, payload)
+SELECT w.id, $1, $2
+FROM webhooks w
+WHERE (cardinality(w.events) = 0 OR $1 = ANY(w.events))
+	AND (w.user_id = $3 OR w.user_id = $4
+		OR EXISTS (SELECT 1 FROM memberships m WHERE m.org_id = $5 AND m.user_id = w.user_id)
+		OR (w.team AND w.org_id = $5 AND EXISTS (SELECT 1 FROM memberships m WHERE m.org_id = w.org_id AND m.user_id = w.user_id)))`
+
+// queryClaimDeliveries takes up to $1 pending deliveries that are due and
+// holds them for $2 seconds, so that a concurrent run skips them and a run
+// after a crash sends them again.
+const queryClaimDeliveries = `WITH due AS (
+	SELECT id FROM webhook_deliveries
+	WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+	ORDER BY next_attempt_at, id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET attempts = d.attempts + 1, next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $2)
+FROM due, webhooks w
+WHERE d.id = due.id AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.redelivery_of, d.created_at, d.delivered_at, w.url, w.secret`
+
+// SaveWebhook saves a webhook. A team webhook is refused with ErrOrgNotFound
+// unless its owner is a member of its organization.
+func (r *CallsRepo) SaveWebhook(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	events := w.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	saved := entity.Webhook{Secret: w.Secret}
+	err := scanWebhook(r.Pool.QueryRow(ctx, querySaveWebhook, w.UserID, w.URL, w.Secret, events, w.Team, w.OrgID), &saved)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, ErrOrgNotFound
+		}
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	return &saved, nil
+}
+
+func (r *CallsRepo) GetWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error) {
+	rows, err := r.Pool.Query(ctx, queryGetWebhooks, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []entity.Webhook{}
+	for rows.Next() {
+		var w entity.Webhook
+		if err := scanWebhook(rows, &w); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes a webhook of the user together with its delivery log.
+func (r *CallsRepo) DeleteWebhook(ctx context.Context, webhookID, userID int64) error {
+	cmdTag, err := r.Pool.Exec(ctx, queryDeleteWebhook, webhookID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries returns the latest deliveries of a webhook of the user.
+func (r *CallsRepo) GetWebhookDeliveries(ctx context.Context, q entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error) {
+	if err := r.checkWebhook(ctx, q.WebhookID, q.UserID); err != nil {
+		return nil, err
+	}
+
+	var b queryBuilder
+	b.where("webhook_id = ?", q.WebhookID)
+	if q.Status != "" {
+		b.where("status = ?", q.Status)
+	}
+	sql := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries` + b.whereClause() + ` ORDER BY id DESC LIMIT ` + b.arg(q.Limit)
+
+	rows, err := r.Pool.Query(ctx, sql, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]entity.WebhookDelivery, 0, q.Limit)
+	for rows.Next() {
+		var d entity.WebhookDelivery
+		if err := rows.Scan(deliveryFields(&d)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// RedeliverWebhook queues a new delivery with the event and payload of a
+// logged one, which is left as it is.
+func (r *CallsRepo) RedeliverWebhook(ctx context.Context, webhookID, deliveryID, userID int64) (*entity.WebhookDelivery, error) {
+	if err := r.checkWebhook(ctx, webhookID, userID); err != nil {
+		return nil, err
+	}
+
+	var d entity.WebhookDelivery
+	if err := r.Pool.QueryRow(ctx, queryRedeliverWebhook, deliveryID, webhookID).Scan(deliveryFields(&d)...); err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+
+	return &d, nil
+}
+
+func (r *CallsRepo) checkWebhook(ctx context.Context, webhookID, userID int64) error {
+	var exists bool
+	if err := r.Pool.QueryRow(ctx, queryWebhookExists, webhookID, userID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check webhook: %w", err)
+	}
+	if !exists {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// webhookEvent is a call change kept for the webhooks with the users who
+// could see the call after it.
+type webhookEvent struct {
+	id         int64
+	callID     int64
+	eventType  string
+	userID     int64
+	ownerID    *int64
+	assigneeID *int64
+	orgID      *int64
+	occurredAt time.Time
+}
+
+// QueueWebhooks turns up to limit call changes kept for the webhooks, oldest
+// first, into deliveries to the webhooks subscribed to them, in one
+// transaction. A delivery carries the call as it is now; the change of a call
+// deleted for good since is dropped unless it is the deletion. It returns the
+// number of changes taken.
+func (r *CallsRepo) QueueWebhooks(ctx context.Context, limit int) (int, error) {
+	var taken int
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		events, err := getWebhookEvents(ctx, tx, limit)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]int64, len(events))
+		callIDs := make([]int64, len(events))
+		for i, e := range events {
+			ids[i] = e.id
+			callIDs[i] = e.callID
+		}
+
+		calls, err := getCallsByIDs(ctx, tx, callIDs)
+		if err != nil {
+			return err
+		}
+
+		batch := &pgx.Batch{}
+		for _, e := range events {
+			event, ok := entity.WebhookEvents[e.eventType]
+			if !ok {
+				continue
+			}
+
+			p := entity.WebhookPayload{
+				Event:      event,
+				OccurredAt: e.occurredAt.UTC(),
+				UserID:     e.userID,
+				CallID:     e.callID,
+			}
+			if event != entity.WebhookCallDeleted {
+				call, ok := calls[e.callID]
+				if !ok {
+					continue
+				}
+				p.Call = &call
+			}
+
+			payload, err := json.Marshal(p)
+			if err != nil {
+				return fmt.Errorf("failed to encode webhook payload: %w", err)
+			}
+			batch.Queue(queryEnqueueWebhooks, event, payload, e.ownerID, e.assigneeID, e.orgID)
+		}
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return fmt.Errorf("failed to enqueue webhooks: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, queryDeleteWebhookEvents, ids); err != nil {
+			return fmt.Errorf("failed to remove queued webhook events: %w", err)
+		}
+
+		taken = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return taken, nil
+}
+
+func getWebhookEvents(ctx context.Context, tx pgx.Tx, limit int) ([]webhookEvent, error) {
+	rows, err := tx.Query(ctx, queryGetWebhookEvents, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []webhookEvent
+	for rows.Next() {
+		var e webhookEvent
+		if err := rows.Scan(&e.id, &e.callID, &e.eventType, &e.userID, &e.ownerID, &e.assigneeID, &e.orgID, &e.occurredAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// getCallsByIDs returns the calls from ids by ID, deleted ones included.
+func getCallsByIDs(ctx context.Context, tx pgx.Tx, ids []int64) (map[int64]entity.CallResponse, error) {
+	rows, err := tx.Query(ctx, queryGetCallsByIDs, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calls: %w", err)
+	}
+	defer rows.Close()
+
+	calls := make(map[int64]entity.CallResponse, len(ids))
+	for rows.Next() {
+		var call entity.CallResponse
+		if err := scanCall(rows, &call); err != nil {
+			return nil, err
+		}
+		calls[call.ID] = call
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return calls, nil
+}
+
+// ClaimWebhookDeliveries returns up to limit due deliveries with the URL and
+// secret of their webhooks and counts the attempt. Other runs skip them for
+// lease.
+func (r *CallsRepo) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.WebhookDelivery, error) {
+	rows, err := r.Pool.Query(ctx, queryClaimDeliveries, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var d entity.WebhookDelivery
+		if err := rows.Scan(append(deliveryFields(&d), &d.URL, &d.Secret)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// SaveDeliveryState records the outcome of a delivery attempt.
+func (r *CallsRepo) SaveDeliveryState(ctx context.Context, d entity.WebhookDelivery) error {
+	_, err := r.Pool.Exec(ctx, querySaveDeliveryState, d.ID, d.Status, d.NextAttemptAt, d.LastStatusCode, d.LastError)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery state: %w", err)
+	}
+	return nil
+}
+
+func scanWebhook(row pgx.Row, w *entity.Webhook) error {
+	return row.Scan(&w.ID, &w.UserID, &w.URL, &w.Events, &w.Team, &w.OrgID, &w.CreatedAt)
+}
+
+// deliveryFields returns scan destinations in the order of deliveryColumns.
+func deliveryFields(d *entity.WebhookDelivery) []any {
+	return []any{
+		&d.ID,
+		&d.WebhookID,
+		&d.Event,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.RedeliveryOf,
+		&d.CreatedAt,
+		&d.DeliveredAt,
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authpb "calls-service/auth-service/proto"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrAssigneeNotFound  = errors.New("assignee not found")
+	ErrAssigneeNotMember = errors.New("assignee is not a member of the organization of the call")
+)
+
+// AssignCall hands a call visible to the user over to another operator, who
+// must be a registered user of the auth service and, for a call of an
+// organization, a member of it.
+func (u *CallsService) AssignCall(ctx context.Context, callID, userID, orgID, assigneeID int64) (*entity.CallResponse, error) {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	_, err := u.authClient.GetUser(ctx, &authpb.GetUserRequest{Id: assigneeID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrAssigneeNotFound
+		}
+		return nil, fmt.Errorf("failed to check assignee: %w", err)
+	}
+
+	return u.assign(ctx, entity.Assignment{CallID: callID, UserID: userID, OrgID: orgID, AssigneeID: &assigneeID})
+}
+
+func (u *CallsService) UnassignCall(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	return u.assign(ctx, entity.Assignment{CallID: callID, UserID: userID, OrgID: orgID})
+}
+
+func (u *CallsService) assign(ctx context.Context, a entity.Assignment) (*entity.CallResponse, error) {
+	call, err := u.repo.AssignCall(ctx, a)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCallNotFound):
+			return nil, ErrCallNotFound
+		case errors.Is(err, repository.ErrAssigneeNotMember):
+			return nil, ErrAssigneeNotMember
+		}
+		return nil, fmt.Errorf("failed to assign call: %w", err)
+	}
+	return call, nil
+}
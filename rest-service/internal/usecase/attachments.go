@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrNotAttachmentAuthor = errors.New("attachment belongs to another user")
+)
+
+// AddAttachment stores a.Size bytes of body as a file attached to a call
+// visible to a.UserID in organization orgID. The file is removed again if its
+// metadata cannot be saved.
+func (u *CallsService) AddAttachment(ctx context.Context, a entity.Attachment, orgID int64, body io.Reader) (*entity.Attachment, error) {
+	if _, err := u.GetUserCallByID(ctx, a.CallID, a.UserID, orgID); err != nil {
+		return nil, err
+	}
+
+	a.StorageKey = fmt.Sprintf("calls/%d/%s", a.CallID, rand.Text())
+	if err := u.files.Put(ctx, a.StorageKey, body, a.Size, a.ContentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	saved, err := u.repo.SaveAttachment(ctx, a)
+	if err != nil {
+		if delErr := u.files.Delete(ctx, a.StorageKey); delErr != nil {
+			err = errors.Join(err, delErr)
+		}
+		return nil, fmt.Errorf("failed to add attachment: %w", err)
+	}
+	return saved, nil
+}
+
+func (u *CallsService) GetAttachments(ctx context.Context, callID, userID, orgID int64) ([]entity.Attachment, error) {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := u.repo.GetAttachments(ctx, callID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// GetAttachment returns an attachment of a call visible to the user together
+// with its file, which the caller must close.
+func (u *CallsService) GetAttachment(ctx context.Context, callID, attachmentID, userID, orgID int64) (*entity.Attachment, io.ReadCloser, error) {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return nil, nil, err
+	}
+
+	a, err := u.repo.GetAttachment(ctx, callID, attachmentID)
+	if err != nil {
+		return nil, nil, attachmentError(err, "failed to get attachment")
+	}
+
+	file, err := u.files.Get(ctx, a.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment file: %w", err)
+	}
+	return a, file, nil
+}
+
+// DeleteAttachment deletes an attachment uploaded by the user together with its file.
+func (u *CallsService) DeleteAttachment(ctx context.Context, callID, attachmentID, userID, orgID int64) error {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return err
+	}
+
+	key, err := u.repo.DeleteAttachment(ctx, callID, attachmentID, userID)
+	if err != nil {
+		return attachmentError(err, "failed to delete attachment")
+	}
+
+	if err := u.files.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete attachment file: %w", err)
+	}
+	return nil
+}
+
+func attachmentError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrAttachmentNotFound):
+		return ErrAttachmentNotFound
+	case errors.Is(err, repository.ErrNotAttachmentAuthor):
+		return ErrNotAttachmentAuthor
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
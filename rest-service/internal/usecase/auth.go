@@ -2,12 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	authpb "calls-service/auth-service/proto"
 
 	"calls-service/rest-service/internal/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var ErrUserNotFound = errors.New("user not found")
+
 func (u *CallsService) RegisterUser(ctx context.Context, req entity.AuthRequest) error {
 	_, err := u.authClient.Register(ctx, &authpb.RegisterRequest{
 		Username: req.Username,
@@ -23,3 +30,15 @@ func (u *CallsService) LoginUser(ctx context.Context, req entity.AuthRequest) (s
 	})
 	return token.Token, err
 }
+
+// GetUser returns a user of the auth service.
+func (u *CallsService) GetUser(ctx context.Context, userID int64) (*entity.User, error) {
+	user, err := u.authClient.GetUser(ctx, &authpb.GetUserRequest{Id: userID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &entity.User{ID: user.Id, Username: user.Username}, nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var ErrUnknownStatus = errors.New("unknown status")
+
+// BulkCreateCalls saves already validated calls in one transaction. Unless
+// force is set, the calls repeating a recent open call fail like in SaveCall,
+// and an atomic request is then not applied at all.
+func (u *CallsService) BulkCreateCalls(ctx context.Context, calls []entity.Call, atomic, force bool) ([]entity.BulkItemResult, error) {
+	for i := range calls {
+		if calls[i].Priority == "" {
+			calls[i].Priority = entity.PriorityNormal
+		}
+		calls[i].DueIn = u.sla.dueIn(calls[i].Priority)
+		if !force {
+			calls[i].DuplicateWithin = u.duplicateWindow
+		}
+	}
+
+	ids, itemErrs, err := u.repo.SaveCalls(ctx, calls, atomic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save calls: %w", err)
+	}
+
+	results := newBulkResults(len(calls))
+	positions := make([]int, len(calls))
+	for i, id := range ids {
+		results[i].ID = id
+		positions[i] = i
+	}
+
+	return applyBulkErrors(results, positions, itemErrs, atomic), nil
+}
+
+// BulkUpdateCallStatus checks every change against the workflow and applies
+// the allowed ones. An atomic request is not applied if any change fails.
+func (u *CallsService) BulkUpdateCallStatus(ctx context.Context, userID, orgID int64, changes []entity.StatusChange, atomic bool) ([]entity.BulkItemResult, error) {
+	ids := make([]int64, len(changes))
+	for i, ch := range changes {
+		ids[i] = ch.CallID
+	}
+
+	calls, err := u.repo.GetUserCallsByIDs(ctx, userID, orgID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calls: %w", err)
+	}
+	current := make(map[int64]string, len(calls))
+	for _, call := range calls {
+		current[call.ID] = call.Status
+	}
+
+	results := newBulkResults(len(changes))
+	var valid []entity.StatusChange
+	var positions []int
+	for i, ch := range changes {
+		results[i].ID = ch.CallID
+
+		from, ok := current[ch.CallID]
+		switch {
+		case !ok:
+			failBulkItem(&results[i], ErrCallNotFound)
+		case !IsKnownStatus(ch.To):
+			failBulkItem(&results[i], ErrUnknownStatus)
+		default:
+			if err := checkTransition(from, ch.To); err != nil {
+				failBulkItem(&results[i], err)
+				continue
+			}
+			valid = append(valid, entity.StatusChange{CallID: ch.CallID, UserID: userID, OrgID: orgID, From: from, To: ch.To})
+			positions = append(positions, i)
+		}
+	}
+
+	if len(valid) == 0 || atomic && len(valid) < len(changes) {
+		return skipRest(results), nil
+	}
+
+	itemErrs, err := u.repo.UpdateCallsStatus(ctx, valid, atomic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update calls status: %w", err)
+	}
+
+	return applyBulkErrors(results, positions, itemErrs, atomic), nil
+}
+
+// BulkDeleteCalls moves the calls to the trash.
+func (u *CallsService) BulkDeleteCalls(ctx context.Context, userID, orgID int64, ids []int64, atomic bool) ([]entity.BulkItemResult, error) {
+	itemErrs, err := u.repo.DeleteCalls(ctx, userID, orgID, ids, atomic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete calls: %w", err)
+	}
+
+	results := newBulkResults(len(ids))
+	positions := make([]int, len(ids))
+	for i, id := range ids {
+		results[i].ID = id
+		positions[i] = i
+	}
+
+	return applyBulkErrors(results, positions, itemErrs, atomic), nil
+}
+
+func newBulkResults(n int) []entity.BulkItemResult {
+	results := make([]entity.BulkItemResult, n)
+	for i := range results {
+		results[i].Index = i
+	}
+	return results
+}
+
+// applyBulkErrors records the repository outcome of the items at positions.
+func applyBulkErrors(results []entity.BulkItemResult, positions []int, itemErrs []error, atomic bool) []entity.BulkItemResult {
+	failed := false
+	for i, pos := range positions {
+		if itemErrs[i] != nil {
+			failBulkItem(&results[pos], bulkError(itemErrs[i]))
+			failed = true
+		}
+	}
+
+	if failed && atomic {
+		return skipRest(results)
+	}
+
+	for _, pos := range positions {
+		if results[pos].Status == "" {
+			results[pos].Status = entity.BulkItemOK
+		}
+	}
+	return results
+}
+
+// skipRest marks every item without an outcome as skipped.
+func skipRest(results []entity.BulkItemResult) []entity.BulkItemResult {
+	for i := range results {
+		if results[i].Status == "" {
+			results[i].Status = entity.BulkItemSkipped
+		}
+	}
+	return results
+}
+
+func failBulkItem(res *entity.BulkItemResult, err error) {
+	res.Status = entity.BulkItemFailed
+	res.Error = err.Error()
+}
+
+func bulkError(err error) error {
+	var dup *repository.DuplicateCallError
+	switch {
+	case errors.As(err, &dup):
+		return &DuplicateCallError{CallID: dup.CallID}
+	case errors.Is(err, repository.ErrDuplicateItem):
+		return ErrDuplicateItem
+	case errors.Is(err, repository.ErrCallNotFound):
+		return ErrCallNotFound
+	case errors.Is(err, repository.ErrStatusChanged):
+		return ErrStatusChanged
+	}
+	return err
+}
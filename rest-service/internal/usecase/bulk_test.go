@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"testing"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyBulkErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		atomic        bool
+		itemErrs      []error
+		expected      []string
+		expectedError string
+	}{
+		{"No repository failures", false, []error{nil, nil}, []string{entity.BulkItemFailed, entity.BulkItemOK, entity.BulkItemOK}, ""},
+		{"Atomic with failed item", true, []error{nil, repository.ErrStatusChanged}, []string{entity.BulkItemFailed, entity.BulkItemSkipped, entity.BulkItemFailed}, ErrStatusChanged.Error()},
+		{"Best effort with failed item", false, []error{nil, repository.ErrStatusChanged}, []string{entity.BulkItemFailed, entity.BulkItemOK, entity.BulkItemFailed}, ErrStatusChanged.Error()},
+		{"Best effort with duplicate", false, []error{nil, &repository.DuplicateCallError{CallID: 7}}, []string{entity.BulkItemFailed, entity.BulkItemOK, entity.BulkItemFailed}, "duplicate of call 7"},
+		{"Atomic with repeated item", true, []error{nil, repository.ErrDuplicateItem}, []string{entity.BulkItemFailed, entity.BulkItemSkipped, entity.BulkItemFailed}, ErrDuplicateItem.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := newBulkResults(3)
+			failBulkItem(&results[0], ErrCallNotFound)
+
+			results = applyBulkErrors(results, []int{1, 2}, tt.itemErrs, tt.atomic)
+
+			var statuses []string
+			for i, res := range results {
+				assert.Equal(t, i, res.Index)
+				statuses = append(statuses, res.Status)
+			}
+			assert.Equal(t, tt.expected, statuses)
+
+			assert.Equal(t, tt.expectedError, results[2].Error)
+		})
+	}
+}
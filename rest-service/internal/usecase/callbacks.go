@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrNoCallback     = errors.New("call has no scheduled callback")
+	ErrCallbackInPast = errors.New("callback time is in the past")
+)
+
+const (
+	defaultCallbacksWithin = 24 * time.Hour
+	defaultCallbacksLimit  = 50
+)
+
+// ScheduleCallback sets the time to call the client back, which must be in the future.
+func (u *CallsService) ScheduleCallback(ctx context.Context, cb entity.Callback) (*entity.CallResponse, error) {
+	if !cb.At.After(time.Now()) {
+		return nil, ErrCallbackInPast
+	}
+
+	call, err := u.repo.ScheduleCallback(ctx, cb)
+	if err != nil {
+		return nil, callbackError(err, "failed to schedule callback")
+	}
+	return call, nil
+}
+
+func (u *CallsService) SnoozeCallback(ctx context.Context, s entity.CallbackSnooze) (*entity.CallResponse, error) {
+	call, err := u.repo.SnoozeCallback(ctx, s)
+	if err != nil {
+		return nil, callbackError(err, "failed to snooze callback")
+	}
+	return call, nil
+}
+
+func (u *CallsService) CancelCallback(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	call, err := u.repo.CancelCallback(ctx, callID, userID, orgID)
+	if err != nil {
+		return nil, callbackError(err, "failed to cancel callback")
+	}
+	return call, nil
+}
+
+// GetUpcomingCallbacks returns the open calls of the user with a callback due
+// within the given time, 24 hours unless set, overdue ones included.
+func (u *CallsService) GetUpcomingCallbacks(ctx context.Context, userID, orgID int64, within time.Duration, limit int) ([]entity.CallResponse, error) {
+	if within <= 0 {
+		within = defaultCallbacksWithin
+	}
+	if limit <= 0 || limit > maxCallsLimit {
+		limit = defaultCallbacksLimit
+	}
+
+	calls, err := u.repo.GetUpcomingCallbacks(ctx, entity.CallbacksQuery{
+		UserID: userID,
+		OrgID:  orgID,
+		Before: time.Now().Add(within),
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming callbacks: %w", err)
+	}
+	return calls, nil
+}
+
+// RemindCallbacks returns the callbacks that have become due since the last
+// check. Each callback is returned once unless it is snoozed or rescheduled.
+func (u *CallsService) RemindCallbacks(ctx context.Context) ([]entity.CallbackDue, error) {
+	due, err := u.repo.MarkCallbacksDue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check callbacks: %w", err)
+	}
+	return due, nil
+}
+
+func callbackError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrCallNotFound):
+		return ErrCallNotFound
+	case errors.Is(err, repository.ErrNoCallback):
+		return ErrNoCallback
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
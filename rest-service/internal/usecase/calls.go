@@ -4,30 +4,111 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"calls-service/rest-service/internal/entity"
 	"calls-service/rest-service/internal/repository"
 )
 
-var ErrCallNotFound = errors.New("call not found")
+var (
+	ErrCallNotFound    = errors.New("call not found")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrVersionConflict = errors.New("call was modified by another request")
+	ErrStatusChanged   = errors.New("call status was changed by another request")
+)
+
+const (
+	defaultCallsLimit = 20
+	maxCallsLimit     = 100
+)
 
-func (u *CallsService) SaveCall(ctx context.Context, call entity.Call) error {
-	if err := u.repo.SaveCall(ctx, call); err != nil {
-		return fmt.Errorf("failed to save call: %w", err)
+// SaveCall creates a call and returns its ID. Unless force is set, a call
+// repeating a recent open call with the same phone number is refused with a
+// *DuplicateCallError.
+func (u *CallsService) SaveCall(ctx context.Context, call entity.Call, force bool) (int64, error) {
+	if call.Priority == "" {
+		call.Priority = entity.PriorityNormal
 	}
-	return nil
+	call.DueIn = u.sla.dueIn(call.Priority)
+	if !force {
+		call.DuplicateWithin = u.duplicateWindow
+	}
+
+	id, err := u.repo.SaveCall(ctx, call)
+	if err != nil {
+		var dup *repository.DuplicateCallError
+		if errors.As(err, &dup) {
+			return 0, &DuplicateCallError{CallID: dup.CallID}
+		}
+		return 0, fmt.Errorf("failed to save call: %w", err)
+	}
+	return id, nil
 }
 
-func (u *CallsService) GetUserCalls(ctx context.Context, userID int64) ([]entity.CallResponse, error) {
-	calls, err := u.repo.GetUserCalls(ctx, userID)
+func (u *CallsService) GetUserCalls(ctx context.Context, q entity.CallsQuery) (*entity.CallsPage, error) {
+	if q.SortBy == "" {
+		q.SortBy = entity.SortByCreatedAt
+	}
+	if q.Limit <= 0 || q.Limit > maxCallsLimit {
+		q.Limit = defaultCallsLimit
+	}
+	if q.After != nil && q.After.SortBy != q.SortBy {
+		return nil, ErrInvalidCursor
+	}
+
+	limit := q.Limit
+	// One extra row tells whether there is a next page.
+	q.Limit++
+
+	calls, err := u.repo.GetUserCalls(ctx, q)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user calls: %w", err)
 	}
-	return calls, nil
+
+	page := &entity.CallsPage{Items: calls}
+	if len(calls) > limit {
+		page.Items = calls[:limit]
+		page.Next = cursorAfter(page.Items[limit-1], q.SortBy)
+	}
+
+	return page, nil
+}
+
+func cursorAfter(call entity.CallResponse, sortBy string) *entity.CallsCursor {
+	cursor := &entity.CallsCursor{SortBy: sortBy, ID: call.ID}
+	switch sortBy {
+	case entity.SortByCreatedAt:
+		cursor.Value = call.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case entity.SortByClientName:
+		cursor.Value = call.ClientName
+	case entity.SortByStatus:
+		cursor.Value = call.Status
+	case entity.SortByID:
+		cursor.Value = strconv.FormatInt(call.ID, 10)
+	}
+	return cursor
 }
 
-func (u *CallsService) GetUserCallByID(ctx context.Context, callID, userID int64) (*entity.CallResponse, error) {
-	call, err := u.repo.GetUserCallByID(ctx, callID, userID)
+func (u *CallsService) SearchCalls(ctx context.Context, q entity.CallsSearchQuery) ([]entity.CallSearchResult, error) {
+	q.Text = strings.TrimSpace(q.Text)
+	if q.Text == "" {
+		return []entity.CallSearchResult{}, nil
+	}
+	if q.Limit <= 0 || q.Limit > maxCallsLimit {
+		q.Limit = defaultCallsLimit
+	}
+
+	results, err := u.repo.SearchCalls(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search calls: %w", err)
+	}
+	return results, nil
+}
+
+func (u *CallsService) GetUserCallByID(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	call, err := u.repo.GetUserCallByID(ctx, callID, userID, orgID)
 	if err != nil {
 		if errors.Is(err, repository.ErrCallNotFound) {
 			return nil, ErrCallNotFound
@@ -37,18 +118,57 @@ func (u *CallsService) GetUserCallByID(ctx context.Context, callID, userID int64
 	return call, nil
 }
 
-func (u *CallsService) UpdateCallStatus(ctx context.Context, callID, userID int64, newStatus string) error {
-	if err := u.repo.UpdateCallStatus(ctx, callID, userID, newStatus); err != nil {
-		if errors.Is(err, repository.ErrCallNotFound) {
+func (u *CallsService) UpdateCall(ctx context.Context, upd entity.CallUpdate) (*entity.CallResponse, error) {
+	if upd.Priority != nil {
+		dueIn := u.sla.dueIn(*upd.Priority)
+		upd.DueIn = &dueIn
+		upd.WarnBefore = u.sla.WarnBefore
+	}
+
+	call, err := u.repo.UpdateCall(ctx, upd)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCallNotFound):
+			return nil, ErrCallNotFound
+		case errors.Is(err, repository.ErrVersionConflict):
+			return nil, ErrVersionConflict
+		}
+		return nil, fmt.Errorf("failed to update call: %w", err)
+	}
+	return call, nil
+}
+
+func (u *CallsService) UpdateCallStatus(ctx context.Context, callID, userID, orgID int64, newStatus string) error {
+	call, err := u.GetUserCallByID(ctx, callID, userID, orgID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTransition(call.Status, newStatus); err != nil {
+		return err
+	}
+
+	err = u.repo.UpdateCallStatus(ctx, entity.StatusChange{
+		CallID: callID,
+		UserID: userID,
+		OrgID:  orgID,
+		From:   call.Status,
+		To:     newStatus,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCallNotFound):
 			return ErrCallNotFound
+		case errors.Is(err, repository.ErrStatusChanged):
+			return ErrStatusChanged
 		}
 		return fmt.Errorf("failed to update call status: %w", err)
 	}
 	return nil
 }
 
-func (u *CallsService) DeleteCall(ctx context.Context, callID int64, userID int64) error {
-	if err := u.repo.DeleteCall(ctx, callID, userID); err != nil {
+func (u *CallsService) DeleteCall(ctx context.Context, callID, userID, orgID int64) error {
+	if err := u.repo.DeleteCall(ctx, callID, userID, orgID); err != nil {
 		if errors.Is(err, repository.ErrCallNotFound) {
 			return ErrCallNotFound
 		}
@@ -56,3 +176,15 @@ func (u *CallsService) DeleteCall(ctx context.Context, callID int64, userID int6
 	}
 	return nil
 }
+
+func (u *CallsService) GetCallHistory(ctx context.Context, callID, userID, orgID int64) ([]entity.CallEvent, error) {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	events, err := u.repo.GetCallHistory(ctx, callID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call history: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var ErrClientNotFound = errors.New("client not found")
+
+// GetClients returns a page of the clients of the calls visible to q.UserID.
+func (u *CallsService) GetClients(ctx context.Context, q entity.ClientsQuery) (*entity.ClientsPage, error) {
+	if q.Limit <= 0 || q.Limit > maxCallsLimit {
+		q.Limit = defaultCallsLimit
+	}
+
+	limit := q.Limit
+	// One extra row tells whether there is a next page.
+	q.Limit++
+
+	clients, err := u.repo.GetClients(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clients: %w", err)
+	}
+
+	page := &entity.ClientsPage{Items: clients}
+	if len(clients) > limit {
+		page.Items = clients[:limit]
+		page.Next = &entity.ClientsCursor{ID: page.Items[limit-1].ID}
+	}
+
+	return page, nil
+}
+
+func (u *CallsService) GetClient(ctx context.Context, clientID, userID, orgID int64) (*entity.Client, error) {
+	client, err := u.repo.GetClient(ctx, clientID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, repository.ErrClientNotFound) {
+			return nil, ErrClientNotFound
+		}
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+	return client, nil
+}
+
+// GetClientsByIDs returns the clients from clientIDs that are visible to the
+// user, by ID.
+func (u *CallsService) GetClientsByIDs(ctx context.Context, userID, orgID int64, clientIDs []int64) (map[int64]entity.Client, error) {
+	clients, err := u.repo.GetClientsByIDs(ctx, userID, orgID, clientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clients: %w", err)
+	}
+
+	byID := make(map[int64]entity.Client, len(clients))
+	for _, client := range clients {
+		byID[client.ID] = client
+	}
+	return byID, nil
+}
+
+// GetClientCalls returns a page of the calls of client q.ClientID, provided
+// the client is visible to q.UserID.
+func (u *CallsService) GetClientCalls(ctx context.Context, q entity.CallsQuery) (*entity.CallsPage, error) {
+	if _, err := u.GetClient(ctx, q.ClientID, q.UserID, q.OrgID); err != nil {
+		return nil, err
+	}
+	return u.GetUserCalls(ctx, q)
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrNotCommentAuthor = errors.New("comment belongs to another user")
+)
+
+// AddComment adds a comment to a call. Like every comment operation it first
+// checks that the call belongs to the user.
+func (u *CallsService) AddComment(ctx context.Context, userID, orgID int64, comment entity.Comment) (*entity.Comment, error) {
+	if _, err := u.GetUserCallByID(ctx, comment.CallID, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	comment.AuthorID = userID
+	saved, err := u.repo.SaveComment(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	return saved, nil
+}
+
+func (u *CallsService) GetComments(ctx context.Context, callID, userID, orgID int64, internal *bool) ([]entity.Comment, error) {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.repo.GetComments(ctx, callID, internal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	return comments, nil
+}
+
+// GetCallsComments returns the comments of the calls from callIDs that are
+// visible to the user, by call. Calls the user cannot see have none.
+func (u *CallsService) GetCallsComments(ctx context.Context, userID, orgID int64, callIDs []int64) (map[int64][]entity.Comment, error) {
+	comments, err := u.repo.GetCallsComments(ctx, userID, orgID, callIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	byCall := make(map[int64][]entity.Comment, len(callIDs))
+	for _, comment := range comments {
+		byCall[comment.CallID] = append(byCall[comment.CallID], comment)
+	}
+	return byCall, nil
+}
+
+func (u *CallsService) UpdateComment(ctx context.Context, upd entity.CommentUpdate) (*entity.Comment, error) {
+	if _, err := u.GetUserCallByID(ctx, upd.CallID, upd.AuthorID, upd.OrgID); err != nil {
+		return nil, err
+	}
+
+	comment, err := u.repo.UpdateComment(ctx, upd)
+	if err != nil {
+		return nil, commentError(err, "failed to update comment")
+	}
+	return comment, nil
+}
+
+func (u *CallsService) DeleteComment(ctx context.Context, callID, commentID, userID, orgID int64) error {
+	if _, err := u.GetUserCallByID(ctx, callID, userID, orgID); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteComment(ctx, callID, commentID, userID); err != nil {
+		return commentError(err, "failed to delete comment")
+	}
+	return nil
+}
+
+func commentError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrCommentNotFound):
+		return ErrCommentNotFound
+	case errors.Is(err, repository.ErrNotCommentAuthor):
+		return ErrNotCommentAuthor
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// ExportCalls streams every call matching q to fn in the order of the list
+// endpoint. Paging fields of q are ignored.
+func (u *CallsService) ExportCalls(ctx context.Context, q entity.CallsQuery, fn func(entity.CallResponse) error) error {
+	if q.SortBy == "" {
+		q.SortBy = entity.SortByCreatedAt
+	}
+
+	if err := u.repo.ExportCalls(ctx, q, fn); err != nil {
+		return fmt.Errorf("failed to export calls: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// ImportCalls stores the already validated calls read from src in one
+// transaction. Unless force is set, the calls SaveCall would refuse as
+// duplicates, counting the earlier calls of src, are left out and listed in
+// the result. A dry run only reads src to the end and stores nothing, so it
+// finds no duplicates.
+func (u *CallsService) ImportCalls(ctx context.Context, src entity.CallSource, dryRun, force bool) (*entity.ImportResult, error) {
+	src = &importDefaults{CallSource: src, sla: u.sla}
+
+	if dryRun {
+		var n int64
+		for src.Next() {
+			n++
+		}
+		return &entity.ImportResult{Imported: n}, src.Err()
+	}
+
+	var duplicateWithin time.Duration
+	if !force {
+		duplicateWithin = u.duplicateWindow
+	}
+
+	res, err := u.repo.ImportCalls(ctx, src, duplicateWithin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import calls: %w", err)
+	}
+	return res, nil
+}
+
+// importDefaults fills in the fields SaveCall would set for an imported call
+// that does not have them.
+type importDefaults struct {
+	entity.CallSource
+	sla SLA
+}
+
+func (s *importDefaults) Call() entity.Call {
+	call := s.CallSource.Call()
+	if call.Priority == "" {
+		call.Priority = entity.PriorityNormal
+	}
+	if call.Status == "" {
+		call.Status = entity.StatusNew
+	}
+	call.DueIn = s.sla.dueIn(call.Priority)
+	return call
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrDuplicateNotFound = errors.New("duplicate call not found")
+	ErrMergeIntoItself   = errors.New("call cannot be merged into itself")
+	ErrDuplicateItem     = errors.New("duplicate of an earlier item")
+	ErrMergeOtherCaller  = errors.New("duplicate call has a different caller")
+	ErrMergeOtherOrg     = errors.New("duplicate call belongs to a different organization")
+)
+
+// DuplicateCallError reports that a new call repeats the recent open call CallID.
+type DuplicateCallError struct {
+	CallID int64
+}
+
+func (e *DuplicateCallError) Error() string {
+	return fmt.Sprintf("duplicate of call %d", e.CallID)
+}
+
+// MergeCalls folds duplicate calls into m.CallID, which keeps their history,
+// comments, attachments and tags. The duplicates go to the trash, from which
+// they cannot be restored. They must share the number and the client of the
+// call and belong to the same organization.
+func (u *CallsService) MergeCalls(ctx context.Context, m entity.CallMerge) (*entity.CallResponse, error) {
+	m.DuplicateIDs = slices.Clone(m.DuplicateIDs)
+	slices.Sort(m.DuplicateIDs)
+	m.DuplicateIDs = slices.Compact(m.DuplicateIDs)
+	if slices.Contains(m.DuplicateIDs, m.CallID) {
+		return nil, ErrMergeIntoItself
+	}
+
+	call, err := u.repo.MergeCalls(ctx, m)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCallNotFound):
+			return nil, ErrCallNotFound
+		case errors.Is(err, repository.ErrDuplicateNotFound):
+			return nil, ErrDuplicateNotFound
+		case errors.Is(err, repository.ErrMergeOtherCaller):
+			return nil, ErrMergeOtherCaller
+		case errors.Is(err, repository.ErrMergeOtherOrg):
+			return nil, ErrMergeOtherOrg
+		}
+		return nil, fmt.Errorf("failed to merge calls: %w", err)
+	}
+	return call, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mergeRepo answers MergeCalls with err and remembers the merge it got.
+type mergeRepo struct {
+	repository.Repository
+	err    error
+	merged entity.CallMerge
+}
+
+func (r *mergeRepo) MergeCalls(_ context.Context, m entity.CallMerge) (*entity.CallResponse, error) {
+	r.merged = m
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &entity.CallResponse{ID: m.CallID}, nil
+}
+
+func TestMergeCalls(t *testing.T) {
+	tests := []struct {
+		name        string
+		duplicates  []int64
+		repoErr     error
+		expectedErr error
+	}{
+		{"Merged", []int64{3, 2, 3}, nil, nil},
+		{"Into itself", []int64{2, 1}, nil, ErrMergeIntoItself},
+		{"Duplicate not found", []int64{2}, repository.ErrDuplicateNotFound, ErrDuplicateNotFound},
+		{"Duplicate from another caller", []int64{2}, repository.ErrMergeOtherCaller, ErrMergeOtherCaller},
+		{"Duplicate from another organization", []int64{2}, repository.ErrMergeOtherOrg, ErrMergeOtherOrg},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mergeRepo{err: tt.repoErr}
+			u := &CallsService{repo: repo}
+
+			call, err := u.MergeCalls(context.Background(), entity.CallMerge{CallID: 1, UserID: 7, DuplicateIDs: tt.duplicates})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, call)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), call.ID)
+			assert.Equal(t, []int64{2, 3}, repo.merged.DuplicateIDs)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authpb "calls-service/auth-service/proto"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrOrgNotFound        = errors.New("organization not found")
+	ErrNotOrgOwner        = errors.New("user is not the owner of the organization")
+	ErrAlreadyMember      = errors.New("user is already a member or invited")
+	ErrInviteeNotFound    = errors.New("invitee not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrMemberNotFound     = errors.New("member not found")
+	ErrOwnerCannotLeave   = errors.New("owner cannot leave the organization")
+)
+
+// CreateOrg creates an organization owned by the user. It does not become
+// active until the user switches to it.
+func (u *CallsService) CreateOrg(ctx context.Context, name string, userID int64) (*entity.Organization, error) {
+	org, err := u.repo.CreateOrg(ctx, name, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+	return org, nil
+}
+
+// GetOrgs returns the organizations of the user, marking orgID, the one active
+// in the session, as active.
+func (u *CallsService) GetOrgs(ctx context.Context, userID, orgID int64) ([]entity.Organization, error) {
+	orgs, err := u.repo.GetUserOrgs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizations: %w", err)
+	}
+	for i := range orgs {
+		orgs[i].Active = orgs[i].ID == orgID
+	}
+	return orgs, nil
+}
+
+func (u *CallsService) GetOrgMembers(ctx context.Context, orgID, userID int64) ([]entity.Member, error) {
+	members, err := u.repo.GetOrgMembers(ctx, orgID, userID)
+	if err != nil {
+		return nil, orgError(err, "failed to get members")
+	}
+	return members, nil
+}
+
+// InviteToOrg invites a registered user of the auth service to an
+// organization on behalf of its owner.
+func (u *CallsService) InviteToOrg(ctx context.Context, inv entity.Invitation) (*entity.Invitation, error) {
+	_, err := u.authClient.GetUser(ctx, &authpb.GetUserRequest{Id: inv.UserID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrInviteeNotFound
+		}
+		return nil, fmt.Errorf("failed to check invitee: %w", err)
+	}
+
+	saved, err := u.repo.SaveInvitation(ctx, inv)
+	if err != nil {
+		return nil, orgError(err, "failed to invite user")
+	}
+	return saved, nil
+}
+
+func (u *CallsService) GetInvitations(ctx context.Context, userID int64) ([]entity.Invitation, error) {
+	invitations, err := u.repo.GetInvitations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+func (u *CallsService) AcceptInvitation(ctx context.Context, invitationID, userID int64) (*entity.Organization, error) {
+	org, err := u.repo.AcceptInvitation(ctx, invitationID, userID)
+	if err != nil {
+		return nil, orgError(err, "failed to accept invitation")
+	}
+	return org, nil
+}
+
+// DeleteInvitation declines an invitation of the user, or revokes one sent by
+// an organization the user owns.
+func (u *CallsService) DeleteInvitation(ctx context.Context, invitationID, userID int64) error {
+	if err := u.repo.DeleteInvitation(ctx, invitationID, userID); err != nil {
+		return orgError(err, "failed to delete invitation")
+	}
+	return nil
+}
+
+// RemoveMember removes a member from an organization. Members stop seeing its
+// calls at once, whatever their token says. The calls the member created in
+// the organization stay with it.
+func (u *CallsService) RemoveMember(ctx context.Context, rm entity.MemberRemoval) error {
+	if err := u.repo.RemoveMember(ctx, rm); err != nil {
+		return orgError(err, "failed to remove member")
+	}
+	return nil
+}
+
+// SwitchOrg returns a new token for the user active in orgID, an organization
+// the user is a member of, or in none if orgID is zero. Tokens issued earlier
+// keep their organization; the next login starts in orgID.
+func (u *CallsService) SwitchOrg(ctx context.Context, userID, orgID int64) (string, error) {
+	resp, err := u.authClient.SwitchOrg(ctx, &authpb.SwitchOrgRequest{UserId: userID, OrgId: orgID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", ErrOrgNotFound
+		}
+		return "", fmt.Errorf("failed to switch organization: %w", err)
+	}
+	return resp.Token, nil
+}
+
+func orgError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrOrgNotFound):
+		return ErrOrgNotFound
+	case errors.Is(err, repository.ErrNotOrgOwner):
+		return ErrNotOrgOwner
+	case errors.Is(err, repository.ErrAlreadyMember):
+		return ErrAlreadyMember
+	case errors.Is(err, repository.ErrInvitationNotFound):
+		return ErrInvitationNotFound
+	case errors.Is(err, repository.ErrMemberNotFound):
+		return ErrMemberNotFound
+	case errors.Is(err, repository.ErrOwnerCannotLeave):
+		return ErrOwnerCannotLeave
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
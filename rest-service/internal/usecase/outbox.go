@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"calls-service/pkg/eventbus"
+	"calls-service/rest-service/internal/entity"
+)
+
+// Outbox relays up to BatchSize call changes at a time from the outbox to
+// Publisher.
+type Outbox struct {
+	Publisher eventbus.Publisher
+	BatchSize int
+}
+
+// RelayOutbox publishes the call changes waiting in the outbox, keyed by call
+// ID, and returns how many were published. The outbox message ID is the
+// message ID, so a change published again after a failure can be dropped by
+// its consumers.
+func (u *CallsService) RelayOutbox(ctx context.Context) (int, error) {
+	n, err := u.repo.PublishOutbox(ctx, u.outbox.BatchSize, func(m entity.OutboxMessage) error {
+		return u.outbox.Publisher.Publish(ctx, eventbus.Message{
+			ID:   strconv.FormatInt(m.ID, 10),
+			Type: m.Event,
+			Key:  strconv.FormatInt(m.CallID, 10),
+			Body: m.Payload,
+		})
+	})
+	if err != nil {
+		return n, fmt.Errorf("failed to relay outbox: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// SLA holds the time allowed to resolve a call of each priority and how long
+// before the deadline a call is flagged as at risk.
+type SLA struct {
+	Deadlines  map[string]time.Duration
+	WarnBefore time.Duration
+}
+
+func (s SLA) dueIn(priority string) time.Duration {
+	return s.Deadlines[priority]
+}
+
+// CheckSLA flags open calls approaching or past their deadline and returns
+// the calls whose SLA state changed.
+func (u *CallsService) CheckSLA(ctx context.Context) ([]entity.SLAFlag, error) {
+	flags, err := u.repo.MarkSLA(ctx, u.sla.WarnBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sla: %w", err)
+	}
+	return flags, nil
+}
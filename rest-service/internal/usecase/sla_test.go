@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// updateRepo accepts every update and remembers the last one.
+type updateRepo struct {
+	repository.Repository
+	updated entity.CallUpdate
+}
+
+func (r *updateRepo) UpdateCall(_ context.Context, upd entity.CallUpdate) (*entity.CallResponse, error) {
+	r.updated = upd
+	return &entity.CallResponse{ID: upd.ID}, nil
+}
+
+func TestUpdateCallMovesDeadline(t *testing.T) {
+	sla := SLA{
+		Deadlines:  map[string]time.Duration{entity.PriorityNormal: 24 * time.Hour, entity.PriorityCritical: time.Hour},
+		WarnBefore: 15 * time.Minute,
+	}
+	critical, description := entity.PriorityCritical, "Callback requested"
+
+	tests := []struct {
+		name          string
+		upd           entity.CallUpdate
+		expectedDueIn *time.Duration
+		expectedWarn  time.Duration
+	}{
+		{"Priority raised", entity.CallUpdate{ID: 1, Priority: &critical}, ptr(time.Hour), 15 * time.Minute},
+		{"Priority kept", entity.CallUpdate{ID: 1, Description: &description}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &updateRepo{}
+			u := &CallsService{repo: repo, sla: sla}
+
+			_, err := u.UpdateCall(context.Background(), tt.upd)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDueIn, repo.updated.DueIn)
+			assert.Equal(t, tt.expectedWarn, repo.updated.WarnBefore)
+		})
+	}
+}
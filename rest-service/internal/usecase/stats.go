@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+const (
+	defaultStatsDays = 30
+	maxStatsDays     = 366
+)
+
+var (
+	ErrInvalidStatsRange = errors.New("invalid statistics date range")
+	ErrNoTeamOrg         = errors.New("team statistics require an active organization")
+)
+
+// GetCallStats returns the statistics of the calls visible to the user, or of
+// the whole organization of the session if q.Team is set. The days default to
+// the last 30 up to today.
+func (u *CallsService) GetCallStats(ctx context.Context, q entity.StatsQuery) (*entity.CallStats, error) {
+	if q.Team && q.OrgID == 0 {
+		return nil, ErrNoTeamOrg
+	}
+
+	from, to, err := statsRange(q.From, q.To, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	q.From, q.To = from, to
+
+	stats, err := u.repo.GetCallStats(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call stats: %w", err)
+	}
+
+	stats.Scope = entity.StatsScopeOwn
+	if q.Team {
+		stats.Scope = entity.StatsScopeTeam
+	}
+	for _, sc := range stats.ByStatus {
+		if sc.Status != entity.StatusResolved && sc.Status != entity.StatusClosed {
+			stats.Open += sc.Count
+		}
+	}
+	return stats, nil
+}
+
+// statsRange fills in the missing ends of the days from and to, both UTC
+// midnights or zero, and checks that the range spans at most maxStatsDays.
+func statsRange(from, to, now time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = now.UTC().Truncate(24 * time.Hour)
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, 1-defaultStatsDays)
+	}
+	if from.After(to) || to.Sub(from) >= maxStatsDays*24*time.Hour {
+		return time.Time{}, time.Time{}, ErrInvalidStatsRange
+	}
+	return from, to, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsRange(t *testing.T) {
+	now := time.Date(2024, 3, 31, 15, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name         string
+		from, to     time.Time
+		expectedFrom time.Time
+		expectedTo   time.Time
+		valid        bool
+	}{
+		{"Defaults to the last 30 days", time.Time{}, time.Time{}, day(3, 2), day(3, 31), true},
+		{"From defaults to 30 days before to", time.Time{}, day(2, 29), day(1, 31), day(2, 29), true},
+		{"To defaults to today", day(3, 30), time.Time{}, day(3, 30), day(3, 31), true},
+		{"Single day", day(3, 1), day(3, 1), day(3, 1), day(3, 1), true},
+		{"Longest range", day(1, 1), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), day(1, 1), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), true},
+		{"Too long", day(1, 1), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, time.Time{}, false},
+		{"From after to", day(3, 2), day(3, 1), time.Time{}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := statsRange(tt.from, tt.to, now)
+			if !tt.valid {
+				assert.ErrorIs(t, err, ErrInvalidStatsRange)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFrom, from)
+			assert.Equal(t, tt.expectedTo, to)
+		})
+	}
+}
+
+func TestGetCallStatsOfTeamWithoutOrg(t *testing.T) {
+	u := &CallsService{}
+
+	_, err := u.GetCallStats(context.Background(), entity.StatsQuery{UserID: 1, Team: true})
+
+	assert.ErrorIs(t, err, ErrNoTeamOrg)
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"github.com/rs/zerolog/log"
+)
+
+// streamBuffer is the number of events a stream may fall behind by before it
+// is closed.
+const streamBuffer = 64
+
+// streamViewer is a user with an open stream and the organization active in
+// the session it was opened in.
+type streamViewer struct {
+	userID int64
+	orgID  int64
+}
+
+// streamHub fans call stream events out to the open streams of each viewer.
+type streamHub struct {
+	mu      sync.Mutex
+	streams map[streamViewer]map[chan entity.CallStreamEvent]struct{}
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{streams: make(map[streamViewer]map[chan entity.CallStreamEvent]struct{})}
+}
+
+func (h *streamHub) open(v streamViewer) chan entity.CallStreamEvent {
+	ch := make(chan entity.CallStreamEvent, streamBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.streams[v] == nil {
+		h.streams[v] = make(map[chan entity.CallStreamEvent]struct{})
+	}
+	h.streams[v][ch] = struct{}{}
+	return ch
+}
+
+// close closes a stream unless it has been closed already.
+func (h *streamHub) close(v streamViewer, ch chan entity.CallStreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closeLocked(v, ch)
+}
+
+func (h *streamHub) closeLocked(v streamViewer, ch chan entity.CallStreamEvent) {
+	if _, ok := h.streams[v][ch]; !ok {
+		return
+	}
+	delete(h.streams[v], ch)
+	if len(h.streams[v]) == 0 {
+		delete(h.streams, v)
+	}
+	close(ch)
+}
+
+func (h *streamHub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for v, streams := range h.streams {
+		for ch := range streams {
+			h.closeLocked(v, ch)
+		}
+	}
+}
+
+// watching returns the viewers with a stream open who are among userIDs or
+// whose session is in organization orgID, unless it is zero.
+func (h *streamHub) watching(userIDs []int64, orgID int64) []streamViewer {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var watching []streamViewer
+	for v := range h.streams {
+		if slices.Contains(userIDs, v.userID) || (orgID != 0 && v.orgID == orgID) {
+			watching = append(watching, v)
+		}
+	}
+	return watching
+}
+
+// send passes the event to every stream of the viewer. A stream that has
+// fallen too far behind is closed, so that its client reconnects and reloads
+// the calls.
+func (h *streamHub) send(v streamViewer, e entity.CallStreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.streams[v] {
+		select {
+		case ch <- e:
+		default:
+			h.closeLocked(v, ch)
+		}
+	}
+}
+
+// SubscribeCalls opens a stream of changes of the calls the user can see in
+// the organization. The stream is closed when ctx is done, when it falls too
+// far behind or when the service stops.
+func (u *CallsService) SubscribeCalls(ctx context.Context, userID, orgID int64) <-chan entity.CallStreamEvent {
+	v := streamViewer{userID: userID, orgID: orgID}
+	ch := u.streams.open(v)
+
+	go func() {
+		<-ctx.Done()
+		u.streams.close(v, ch)
+	}()
+
+	return ch
+}
+
+// CloseCallStreams closes every open stream of call changes.
+func (u *CallsService) CloseCallStreams() {
+	u.streams.closeAll()
+}
+
+// ListenCallChanges passes the call changes made by every instance of the
+// service to the open streams until ctx is done or the database connection
+// fails. Changes made while it is not running are not passed on.
+func (u *CallsService) ListenCallChanges(ctx context.Context) error {
+	err := u.repo.ListenCallChanges(ctx, func(n entity.CallNotification) {
+		u.dispatch(ctx, n)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to listen for call changes: %w", err)
+	}
+	return nil
+}
+
+// dispatch passes a call change to the viewers who could see the call. A
+// viewer who is matched only by the organization of their session must still
+// be a member of it, so that users removed from it stop getting its changes.
+func (u *CallsService) dispatch(ctx context.Context, n entity.CallNotification) {
+	for _, v := range u.streams.watching(n.Viewers, n.OrgID) {
+		if !slices.Contains(n.Viewers, v.userID) {
+			_, err := u.repo.GetUserOrg(ctx, v.orgID, v.userID)
+			switch {
+			case errors.Is(err, repository.ErrOrgNotFound):
+				continue
+			case err != nil:
+				log.Error().Err(err).Int64("orgID", v.orgID).Msg("failed to check membership for streams")
+				continue
+			}
+		}
+
+		e := entity.CallStreamEvent{ID: n.ID, Event: n.Event, CallID: n.CallID}
+
+		switch n.Event {
+		case entity.EventDeleted, entity.EventPurged:
+			e.Type = entity.StreamCallDeleted
+		default:
+			call, err := u.repo.GetUserCallByID(ctx, n.CallID, v.userID, v.orgID)
+			switch {
+			case err == nil:
+				e.Type = streamEventType(n.Event)
+				e.Call = call
+			case errors.Is(err, repository.ErrCallNotFound):
+				// The call has left the view of the user, e.g. on reassignment.
+				e.Type = entity.StreamCallDeleted
+			default:
+				log.Error().Err(err).Int64("callID", n.CallID).Msg("failed to read call for streams")
+				continue
+			}
+		}
+
+		u.streams.send(v, e)
+	}
+}
+
+func streamEventType(event string) string {
+	switch event {
+	case entity.EventCreated, entity.EventRestored:
+		return entity.StreamCallCreated
+	}
+	return entity.StreamCallUpdated
+}
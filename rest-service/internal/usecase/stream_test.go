@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamHub(t *testing.T) {
+	h := newStreamHub()
+	alice, aliceInOrg, bob := streamViewer{userID: 1}, streamViewer{userID: 1, orgID: 5}, streamViewer{userID: 2}
+	first := h.open(alice)
+	second := h.open(alice)
+	inOrg := h.open(aliceInOrg)
+	other := h.open(bob)
+
+	assert.ElementsMatch(t, []streamViewer{alice, aliceInOrg, bob}, h.watching([]int64{1, 2, 3}, 0))
+	assert.ElementsMatch(t, []streamViewer{aliceInOrg, bob}, h.watching([]int64{2}, 5))
+
+	h.send(alice, entity.CallStreamEvent{ID: 1, CallID: 7})
+	assert.Equal(t, int64(1), (<-first).ID)
+	assert.Equal(t, int64(1), (<-second).ID)
+	assert.Empty(t, inOrg)
+	assert.Empty(t, other)
+
+	h.close(alice, first)
+	h.close(alice, first)
+	_, open := <-first
+	assert.False(t, open)
+
+	// A stream that falls behind is closed and the others keep going.
+	for i := range streamBuffer + 1 {
+		h.send(alice, entity.CallStreamEvent{ID: int64(i)})
+	}
+	assert.Len(t, second, streamBuffer)
+	assert.ElementsMatch(t, []streamViewer{aliceInOrg, bob}, h.watching([]int64{1, 2}, 0))
+
+	h.closeAll()
+	_, open = <-other
+	assert.False(t, open)
+	assert.Empty(t, h.watching([]int64{1, 2}, 5))
+}
+
+func TestStreamEventType(t *testing.T) {
+	assert.Equal(t, entity.StreamCallCreated, streamEventType(entity.EventCreated))
+	assert.Equal(t, entity.StreamCallCreated, streamEventType(entity.EventRestored))
+	assert.Equal(t, entity.StreamCallUpdated, streamEventType(entity.EventAssigned))
+}
+
+// streamRepo lets members see every call in their organization and owners
+// see the call.
+type streamRepo struct {
+	repository.Repository
+	members map[int64]int64
+	owners  []int64
+}
+
+func (r *streamRepo) GetUserOrg(_ context.Context, orgID, userID int64) (*entity.Organization, error) {
+	if r.members[userID] != orgID {
+		return nil, repository.ErrOrgNotFound
+	}
+	return &entity.Organization{ID: orgID}, nil
+}
+
+func (r *streamRepo) GetUserCallByID(_ context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	if !slices.Contains(r.owners, userID) && (orgID == 0 || r.members[userID] != orgID) {
+		return nil, repository.ErrCallNotFound
+	}
+	return &entity.CallResponse{ID: callID}, nil
+}
+
+func TestDispatch(t *testing.T) {
+	owner, member, removed, stranger := streamViewer{userID: 1, orgID: 5}, streamViewer{userID: 2, orgID: 5}, streamViewer{userID: 3, orgID: 5}, streamViewer{userID: 4}
+
+	tests := []struct {
+		name     string
+		event    string
+		orgID    int64
+		viewers  []int64
+		owners   []int64
+		expected map[streamViewer]string
+	}{
+		{
+			name: "Update of an organization call", event: entity.EventUpdated, orgID: 5, viewers: []int64{1}, owners: []int64{1},
+			expected: map[streamViewer]string{owner: entity.StreamCallUpdated, member: entity.StreamCallUpdated},
+		},
+		{
+			name: "Deletion of an organization call", event: entity.EventDeleted, orgID: 5, viewers: []int64{1},
+			expected: map[streamViewer]string{owner: entity.StreamCallDeleted, member: entity.StreamCallDeleted},
+		},
+		{
+			name: "Deletion of a personal call", event: entity.EventDeleted, viewers: []int64{4},
+			expected: map[streamViewer]string{stranger: entity.StreamCallDeleted},
+		},
+		{
+			name: "Reassignment of a personal call", event: entity.EventAssigned, viewers: []int64{1, 4}, owners: []int64{1},
+			expected: map[streamViewer]string{owner: entity.StreamCallUpdated, stranger: entity.StreamCallDeleted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &CallsService{
+				repo:    &streamRepo{members: map[int64]int64{1: 5, 2: 5}, owners: tt.owners},
+				streams: newStreamHub(),
+			}
+			streams := map[streamViewer]chan entity.CallStreamEvent{}
+			for _, v := range []streamViewer{owner, member, removed, stranger} {
+				streams[v] = u.streams.open(v)
+			}
+
+			u.dispatch(context.Background(), entity.CallNotification{ID: 1, CallID: 7, Event: tt.event, OrgID: tt.orgID, Viewers: tt.viewers})
+
+			for v, ch := range streams {
+				expected, ok := tt.expected[v]
+				if !ok {
+					assert.Empty(t, ch, "user %d", v.userID)
+					continue
+				}
+				e := <-ch
+				assert.Equal(t, expected, e.Type, "user %d", v.userID)
+				assert.Equal(t, int64(7), e.CallID)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagExists      = errors.New("tag already exists")
+	ErrTagNotAttached = errors.New("tag is not attached to the call")
+)
+
+// CreateTag adds a tag to the catalogue of tag.UserID.
+func (u *CallsService) CreateTag(ctx context.Context, tag entity.Tag) (*entity.Tag, error) {
+	saved, err := u.repo.SaveTag(ctx, tag)
+	if err != nil {
+		return nil, tagError(err, "failed to create tag")
+	}
+	return saved, nil
+}
+
+func (u *CallsService) GetTags(ctx context.Context, userID int64) ([]entity.Tag, error) {
+	tags, err := u.repo.GetTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
+}
+
+func (u *CallsService) RenameTag(ctx context.Context, tag entity.Tag) (*entity.Tag, error) {
+	renamed, err := u.repo.RenameTag(ctx, tag)
+	if err != nil {
+		return nil, tagError(err, "failed to rename tag")
+	}
+	return renamed, nil
+}
+
+// DeleteTag removes a tag from the catalogue of the user and detaches it from
+// every call.
+func (u *CallsService) DeleteTag(ctx context.Context, tagID, userID int64) error {
+	if err := u.repo.DeleteTag(ctx, tagID, userID); err != nil {
+		return tagError(err, "failed to delete tag")
+	}
+	return nil
+}
+
+// AttachTag attaches a tag of the user's catalogue to a call visible to them.
+func (u *CallsService) AttachTag(ctx context.Context, ch entity.TagChange) (*entity.CallResponse, error) {
+	call, err := u.repo.AttachTag(ctx, ch)
+	if err != nil {
+		return nil, tagError(err, "failed to attach tag")
+	}
+	return call, nil
+}
+
+func (u *CallsService) DetachTag(ctx context.Context, ch entity.TagChange) (*entity.CallResponse, error) {
+	call, err := u.repo.DetachTag(ctx, ch)
+	if err != nil {
+		return nil, tagError(err, "failed to detach tag")
+	}
+	return call, nil
+}
+
+func tagError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrCallNotFound):
+		return ErrCallNotFound
+	case errors.Is(err, repository.ErrTagNotFound):
+		return ErrTagNotFound
+	case errors.Is(err, repository.ErrTagExists):
+		return ErrTagExists
+	case errors.Is(err, repository.ErrTagNotAttached):
+		return ErrTagNotAttached
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+// RestoreCall takes a call visible to the user back out of the trash.
+func (u *CallsService) RestoreCall(ctx context.Context, callID, userID, orgID int64) (*entity.CallResponse, error) {
+	call, err := u.repo.RestoreCall(ctx, callID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCallNotFound) {
+			return nil, ErrCallNotFound
+		}
+		return nil, fmt.Errorf("failed to restore call: %w", err)
+	}
+	return call, nil
+}
+
+// PurgeTrash permanently deletes calls that have been in the trash for longer
+// than retention, along with their attachment files, and returns how many
+// calls were deleted.
+func (u *CallsService) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	n, keys, err := u.repo.PurgeCalls(ctx, retention)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	var errs []error
+	for _, key := range keys {
+		if err := u.files.Delete(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return n, fmt.Errorf("failed to delete %d attachment files: %w", len(errs), errors.Join(errs...))
+	}
+	return n, nil
+}
@@ -2,31 +2,103 @@ package usecase
 
 import (
 	"context"
+	"io"
+	"time"
 
 	authpb "calls-service/auth-service/proto"
 
+	"calls-service/pkg/storage"
 	"calls-service/rest-service/internal/entity"
 	"calls-service/rest-service/internal/repository"
 )
 
 type UseCase interface {
-	SaveCall(context.Context, entity.Call) error
-	GetUserCalls(context.Context, int64) ([]entity.CallResponse, error)
-	GetUserCallByID(context.Context, int64, int64) (*entity.CallResponse, error)
-	UpdateCallStatus(context.Context, int64, int64, string) error
-	DeleteCall(context.Context, int64, int64) error
+	SaveCall(context.Context, entity.Call, bool) (int64, error)
+	GetUserCalls(context.Context, entity.CallsQuery) (*entity.CallsPage, error)
+	ExportCalls(context.Context, entity.CallsQuery, func(entity.CallResponse) error) error
+	SearchCalls(context.Context, entity.CallsSearchQuery) ([]entity.CallSearchResult, error)
+	GetUserCallByID(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	UpdateCall(context.Context, entity.CallUpdate) (*entity.CallResponse, error)
+	UpdateCallStatus(context.Context, int64, int64, int64, string) error
+	AssignCall(context.Context, int64, int64, int64, int64) (*entity.CallResponse, error)
+	UnassignCall(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	DeleteCall(context.Context, int64, int64, int64) error
+	MergeCalls(context.Context, entity.CallMerge) (*entity.CallResponse, error)
+	BulkCreateCalls(context.Context, []entity.Call, bool, bool) ([]entity.BulkItemResult, error)
+	ImportCalls(context.Context, entity.CallSource, bool, bool) (*entity.ImportResult, error)
+	BulkUpdateCallStatus(context.Context, int64, int64, []entity.StatusChange, bool) ([]entity.BulkItemResult, error)
+	BulkDeleteCalls(context.Context, int64, int64, []int64, bool) ([]entity.BulkItemResult, error)
+	RestoreCall(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	GetCallHistory(context.Context, int64, int64, int64) ([]entity.CallEvent, error)
+	AddComment(context.Context, int64, int64, entity.Comment) (*entity.Comment, error)
+	GetComments(context.Context, int64, int64, int64, *bool) ([]entity.Comment, error)
+	GetCallsComments(context.Context, int64, int64, []int64) (map[int64][]entity.Comment, error)
+	UpdateComment(context.Context, entity.CommentUpdate) (*entity.Comment, error)
+	DeleteComment(context.Context, int64, int64, int64, int64) error
+	AddAttachment(context.Context, entity.Attachment, int64, io.Reader) (*entity.Attachment, error)
+	GetAttachments(context.Context, int64, int64, int64) ([]entity.Attachment, error)
+	GetAttachment(context.Context, int64, int64, int64, int64) (*entity.Attachment, io.ReadCloser, error)
+	DeleteAttachment(context.Context, int64, int64, int64, int64) error
+	CreateTag(context.Context, entity.Tag) (*entity.Tag, error)
+	GetTags(context.Context, int64) ([]entity.Tag, error)
+	RenameTag(context.Context, entity.Tag) (*entity.Tag, error)
+	DeleteTag(context.Context, int64, int64) error
+	AttachTag(context.Context, entity.TagChange) (*entity.CallResponse, error)
+	DetachTag(context.Context, entity.TagChange) (*entity.CallResponse, error)
+	GetClients(context.Context, entity.ClientsQuery) (*entity.ClientsPage, error)
+	GetClient(context.Context, int64, int64, int64) (*entity.Client, error)
+	GetClientsByIDs(context.Context, int64, int64, []int64) (map[int64]entity.Client, error)
+	GetClientCalls(context.Context, entity.CallsQuery) (*entity.CallsPage, error)
+	GetCallStats(context.Context, entity.StatsQuery) (*entity.CallStats, error)
+	ScheduleCallback(context.Context, entity.Callback) (*entity.CallResponse, error)
+	SnoozeCallback(context.Context, entity.CallbackSnooze) (*entity.CallResponse, error)
+	CancelCallback(context.Context, int64, int64, int64) (*entity.CallResponse, error)
+	GetUpcomingCallbacks(context.Context, int64, int64, time.Duration, int) ([]entity.CallResponse, error)
+	SubscribeCalls(context.Context, int64, int64) <-chan entity.CallStreamEvent
+	CreateWebhook(context.Context, entity.Webhook) (*entity.Webhook, error)
+	GetWebhooks(context.Context, int64) ([]entity.Webhook, error)
+	DeleteWebhook(context.Context, int64, int64) error
+	GetWebhookDeliveries(context.Context, entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error)
+	RedeliverWebhook(context.Context, int64, int64, int64) (*entity.WebhookDelivery, error)
 	RegisterUser(context.Context, entity.AuthRequest) error
 	LoginUser(context.Context, entity.AuthRequest) (string, error)
+	GetUser(context.Context, int64) (*entity.User, error)
+	CreateOrg(context.Context, string, int64) (*entity.Organization, error)
+	GetOrgs(context.Context, int64, int64) ([]entity.Organization, error)
+	GetOrgMembers(context.Context, int64, int64) ([]entity.Member, error)
+	InviteToOrg(context.Context, entity.Invitation) (*entity.Invitation, error)
+	GetInvitations(context.Context, int64) ([]entity.Invitation, error)
+	AcceptInvitation(context.Context, int64, int64) (*entity.Organization, error)
+	DeleteInvitation(context.Context, int64, int64) error
+	RemoveMember(context.Context, entity.MemberRemoval) error
+	SwitchOrg(context.Context, int64, int64) (string, error)
 }
 
 type CallsService struct {
-	repo       repository.Repository
-	authClient authpb.AuthServiceClient
+	repo            repository.Repository
+	authClient      authpb.AuthServiceClient
+	sla             SLA
+	duplicateWindow time.Duration
+	files           storage.Storage
+	webhooks        Webhooks
+	outbox          Outbox
+	streams         *streamHub
 }
 
-func New(repo repository.Repository, authClient authpb.AuthServiceClient) *CallsService {
+// New returns the calls service. A new call is refused as a duplicate if its
+// phone number has an open call created within duplicateWindow; zero turns
+// the check off. Files attached to calls are kept in files. Changes of calls
+// are sent to the subscribed webhooks as set by webhooks and relayed from the
+// outbox to downstream systems as set by outbox.
+func New(repo repository.Repository, authClient authpb.AuthServiceClient, sla SLA, duplicateWindow time.Duration, files storage.Storage, webhooks Webhooks, outbox Outbox) *CallsService {
 	return &CallsService{
-		repo:       repo,
-		authClient: authClient,
+		repo:            repo,
+		authClient:      authClient,
+		sla:             sla,
+		duplicateWindow: duplicateWindow,
+		files:           files,
+		webhooks:        webhooks,
+		outbox:          outbox,
+		streams:         newStreamHub(),
 	}
 }
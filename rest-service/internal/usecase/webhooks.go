@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"calls-service/pkg/webhook"
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/repository"
+)
+
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrDeliveryNotFound    = errors.New("webhook delivery not found")
+	ErrWebhookHostNotFound = errors.New("webhook host not found")
+	ErrWebhookNotPublic    = errors.New("webhook address is not public")
+)
+
+const defaultDeliveriesLimit = 50
+
+// webhookQueueBatch is the number of call changes queued for webhooks at a time.
+const webhookQueueBatch = 500
+
+type WebhookSender interface {
+	Send(context.Context, webhook.Message) (int, error)
+}
+
+// Webhooks sends up to BatchSize due deliveries at a time with Sender. A
+// delivery is held for Lease while it is sent. A failed one is retried up to
+// MaxAttempts times in all, after a delay doubling from BackoffBase up to
+// BackoffMax. Unless AllowPrivate is set, webhooks may only be created for
+// hosts with public addresses.
+type Webhooks struct {
+	Sender       WebhookSender
+	BatchSize    int
+	Lease        time.Duration
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	AllowPrivate bool
+}
+
+// backoff returns the delay after the given failed attempt, counted from 1.
+func (w Webhooks) backoff(attempt int) time.Duration {
+	d := w.BackoffBase
+	for i := 1; i < attempt && d < w.BackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, w.BackoffMax)
+}
+
+// settle sets the state of a delivery after an attempt that failed with err,
+// or succeeded if err is nil.
+func (w Webhooks) settle(d *entity.WebhookDelivery, err error, now time.Time) {
+	d.NextAttemptAt = nil
+	if err == nil {
+		d.Status = entity.DeliveryDelivered
+		d.LastError = nil
+		return
+	}
+
+	msg := err.Error()
+	d.LastError = &msg
+	if d.Attempts >= w.MaxAttempts {
+		d.Status = entity.DeliveryFailed
+		return
+	}
+
+	next := now.Add(w.backoff(d.Attempts))
+	d.Status = entity.DeliveryPending
+	d.NextAttemptAt = &next
+}
+
+// CreateWebhook subscribes the user to call events and generates the secret
+// that signs the deliveries. The host of the URL must resolve to public
+// addresses only; the sender checks them again on every delivery, since DNS
+// may change in between.
+func (u *CallsService) CreateWebhook(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	if !u.webhooks.AllowPrivate {
+		err := webhook.CheckURL(ctx, w.URL)
+		var dnsErr *net.DNSError
+		switch {
+		case errors.Is(err, webhook.ErrForbiddenAddress):
+			return nil, ErrWebhookNotPublic
+		case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+			return nil, ErrWebhookHostNotFound
+		case err != nil:
+			return nil, fmt.Errorf("failed to check webhook URL: %w", err)
+		}
+	}
+
+	w.Secret = rand.Text()
+
+	saved, err := u.repo.SaveWebhook(ctx, w)
+	if err != nil {
+		return nil, webhookError(err, "failed to create webhook")
+	}
+	return saved, nil
+}
+
+func (u *CallsService) GetWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error) {
+	webhooks, err := u.repo.GetWebhooks(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (u *CallsService) DeleteWebhook(ctx context.Context, webhookID, userID int64) error {
+	if err := u.repo.DeleteWebhook(ctx, webhookID, userID); err != nil {
+		return webhookError(err, "failed to delete webhook")
+	}
+	return nil
+}
+
+// GetWebhookDeliveries returns the delivery log of a webhook, the latest first.
+func (u *CallsService) GetWebhookDeliveries(ctx context.Context, q entity.WebhookDeliveriesQuery) ([]entity.WebhookDelivery, error) {
+	if q.Limit <= 0 || q.Limit > maxCallsLimit {
+		q.Limit = defaultDeliveriesLimit
+	}
+
+	deliveries, err := u.repo.GetWebhookDeliveries(ctx, q)
+	if err != nil {
+		return nil, webhookError(err, "failed to get webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// RedeliverWebhook sends a logged delivery again as a new delivery.
+func (u *CallsService) RedeliverWebhook(ctx context.Context, webhookID, deliveryID, userID int64) (*entity.WebhookDelivery, error) {
+	d, err := u.repo.RedeliverWebhook(ctx, webhookID, deliveryID, userID)
+	if err != nil {
+		return nil, webhookError(err, "failed to redeliver webhook")
+	}
+	return d, nil
+}
+
+// DeliverWebhooks queues deliveries of the call changes saved since the last
+// run, then sends the due deliveries concurrently and returns them with the
+// outcome of the attempt.
+func (u *CallsService) DeliverWebhooks(ctx context.Context) ([]entity.WebhookDelivery, error) {
+	for {
+		n, err := u.repo.QueueWebhooks(ctx, webhookQueueBatch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to queue webhooks: %w", err)
+		}
+		if n < webhookQueueBatch {
+			break
+		}
+	}
+
+	deliveries, err := u.repo.ClaimWebhookDeliveries(ctx, u.webhooks.BatchSize, u.webhooks.Lease)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deliver webhooks: %w", err)
+	}
+
+	errs := make([]error, len(deliveries))
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = u.deliver(ctx, &deliveries[i])
+		}()
+	}
+	wg.Wait()
+
+	return deliveries, errors.Join(errs...)
+}
+
+func (u *CallsService) deliver(ctx context.Context, d *entity.WebhookDelivery) error {
+	status, err := u.webhooks.Sender.Send(ctx, webhook.Message{
+		URL:        d.URL,
+		Secret:     d.Secret,
+		Event:      d.Event,
+		DeliveryID: d.ID,
+		Body:       d.Payload,
+	})
+
+	d.LastStatusCode = nil
+	if status != 0 {
+		d.LastStatusCode = &status
+	}
+	u.webhooks.settle(d, err, time.Now())
+
+	if err := u.repo.SaveDeliveryState(ctx, *d); err != nil {
+		return fmt.Errorf("delivery %d: %w", d.ID, err)
+	}
+	return nil
+}
+
+func webhookError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repository.ErrWebhookNotFound):
+		return ErrWebhookNotFound
+	case errors.Is(err, repository.ErrDeliveryNotFound):
+		return ErrDeliveryNotFound
+	case errors.Is(err, repository.ErrOrgNotFound):
+		return ErrOrgNotFound
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
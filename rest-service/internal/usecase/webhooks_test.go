@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	w := Webhooks{BackoffBase: 30 * time.Second, BackoffMax: 5 * time.Minute}
+
+	expected := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, d := range expected {
+		assert.Equal(t, d, w.backoff(i+1), "attempt %d", i+1)
+	}
+}
+
+func TestSettleDelivery(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	w := Webhooks{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: time.Hour}
+	failure := errors.New("connection refused")
+
+	tests := []struct {
+		name          string
+		attempts      int
+		err           error
+		expectedState string
+		expectedNext  *time.Time
+	}{
+		{"Delivered", 1, nil, entity.DeliveryDelivered, nil},
+		{"Retried after backoff", 2, failure, entity.DeliveryPending, ptr(now.Add(2 * time.Minute))},
+		{"Failed after the last attempt", 3, failure, entity.DeliveryFailed, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := entity.WebhookDelivery{Attempts: tt.attempts, Status: entity.DeliveryPending}
+
+			w.settle(&d, tt.err, now)
+
+			assert.Equal(t, tt.expectedState, d.Status)
+			assert.Equal(t, tt.expectedNext, d.NextAttemptAt)
+			if tt.err != nil {
+				assert.Equal(t, tt.err.Error(), *d.LastError)
+			} else {
+				assert.Nil(t, d.LastError)
+			}
+		})
+	}
+}
+
+func TestCreateWebhookForPrivateAddress(t *testing.T) {
+	u := &CallsService{}
+
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hooks",
+		"http://[::1]/hooks",
+		"http://10.0.0.5/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::ffff:192.168.1.1]/hooks",
+	} {
+		t.Run(url, func(t *testing.T) {
+			_, err := u.CreateWebhook(context.Background(), entity.Webhook{UserID: 1, URL: url})
+
+			assert.ErrorIs(t, err, ErrWebhookNotPublic)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
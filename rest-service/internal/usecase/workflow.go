@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+
+	"calls-service/rest-service/internal/entity"
+)
+
+// callTransitions defines the call status workflow: the statuses a call may
+// move to from each status.
+var callTransitions = map[string][]string{
+	entity.StatusNew:        {entity.StatusInProgress, entity.StatusClosed},
+	entity.StatusInProgress: {entity.StatusOnHold, entity.StatusResolved},
+	entity.StatusOnHold:     {entity.StatusInProgress, entity.StatusResolved},
+	entity.StatusResolved:   {entity.StatusClosed, entity.StatusReopened},
+	entity.StatusClosed:     {entity.StatusReopened},
+	entity.StatusReopened:   {entity.StatusInProgress, entity.StatusOnHold, entity.StatusResolved},
+}
+
+// TransitionError reports an illegal status change together with the
+// statuses the call may move to instead.
+type TransitionError struct {
+	From    string
+	To      string
+	Allowed []string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("transition from %q to %q is not allowed", e.From, e.To)
+}
+
+// IsKnownStatus reports whether s is a status code of the workflow.
+func IsKnownStatus(s string) bool {
+	_, ok := callTransitions[s]
+	return ok
+}
+
+// AllowedTransitions returns the statuses a call in status from may move to.
+func AllowedTransitions(from string) []string {
+	return slices.Clone(callTransitions[from])
+}
+
+func checkTransition(from, to string) error {
+	if !slices.Contains(callTransitions[from], to) {
+		return &TransitionError{From: from, To: to, Allowed: AllowedTransitions(from)}
+	}
+	return nil
+}
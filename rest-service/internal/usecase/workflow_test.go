@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		allowed bool
+	}{
+		{"New to in progress", entity.StatusNew, entity.StatusInProgress, true},
+		{"In progress to on hold", entity.StatusInProgress, entity.StatusOnHold, true},
+		{"On hold to resolved", entity.StatusOnHold, entity.StatusResolved, true},
+		{"Resolved to closed", entity.StatusResolved, entity.StatusClosed, true},
+		{"Closed to reopened", entity.StatusClosed, entity.StatusReopened, true},
+		{"Reopened to in progress", entity.StatusReopened, entity.StatusInProgress, true},
+		{"New to resolved", entity.StatusNew, entity.StatusResolved, false},
+		{"Closed to in progress", entity.StatusClosed, entity.StatusInProgress, false},
+		{"Same status", entity.StatusOnHold, entity.StatusOnHold, false},
+		{"Unknown source status", "открыта", entity.StatusClosed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTransition(tt.from, tt.to)
+			if tt.allowed {
+				assert.NoError(t, err)
+				return
+			}
+
+			var transitionErr *TransitionError
+			assert.True(t, errors.As(err, &transitionErr))
+			assert.Equal(t, AllowedTransitions(tt.from), transitionErr.Allowed)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/rs/zerolog"
+)
+
+type CallbackReminder interface {
+	RemindCallbacks(context.Context) ([]entity.CallbackDue, error)
+}
+
+// NewCallbacks returns a worker that periodically reports the callbacks that
+// have become due.
+func NewCallbacks(reminder CallbackReminder, interval time.Duration, l zerolog.Logger) *Worker {
+	return New(interval, func(ctx context.Context) {
+		due, err := reminder.RemindCallbacks(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				l.Error().Err(err).Msg("worker - Callbacks - RemindCallbacks")
+			}
+			return
+		}
+
+		for _, d := range due {
+			event := l.Info().
+				Int64("callID", d.CallID).
+				Int64("userID", d.UserID)
+			if d.AssigneeID != nil {
+				event = event.Int64("assigneeID", *d.AssigneeID)
+			}
+			event.Time("callbackAt", d.CallbackAt).
+				Str("timezone", d.Timezone).
+				Msg("Callback due")
+		}
+	})
+}
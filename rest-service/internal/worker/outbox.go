@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type OutboxRelay interface {
+	RelayOutbox(context.Context) (int, error)
+}
+
+// NewOutbox returns a worker that periodically publishes the call changes
+// waiting in the outbox.
+func NewOutbox(relay OutboxRelay, interval time.Duration, l zerolog.Logger) *Worker {
+	return New(interval, func(ctx context.Context) {
+		// Changes of other calls are published even if some of them failed.
+		n, err := relay.RelayOutbox(ctx)
+		if err != nil && ctx.Err() == nil {
+			l.Error().Err(err).Msg("worker - Outbox - RelayOutbox")
+		}
+
+		if n > 0 {
+			l.Debug().Int("count", n).Msg("Outbox messages published")
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type TrashPurger interface {
+	PurgeTrash(context.Context, time.Duration) (int64, error)
+}
+
+// NewPurge returns a worker that periodically deletes calls that have been in
+// the trash for longer than retention.
+func NewPurge(purger TrashPurger, retention, interval time.Duration, l zerolog.Logger) *Worker {
+	return New(interval, func(ctx context.Context) {
+		// Calls may have been purged even if deleting their files failed.
+		n, err := purger.PurgeTrash(ctx, retention)
+		if err != nil && ctx.Err() == nil {
+			l.Error().Err(err).Msg("worker - Purge - PurgeTrash")
+		}
+
+		if n > 0 {
+			l.Info().Int64("count", n).Msg("Trashed calls purged")
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/rs/zerolog"
+)
+
+type SLAChecker interface {
+	CheckSLA(context.Context) ([]entity.SLAFlag, error)
+}
+
+// NewSLA returns a worker that periodically flags calls that are approaching
+// or past their deadline. The owner and the assignee of a flagged call learn
+// of it from the sla_changed message the flag leaves in the outbox, which
+// reaches their live streams and call.sla_changed webhooks.
+func NewSLA(checker SLAChecker, interval time.Duration, l zerolog.Logger) *Worker {
+	return New(interval, func(ctx context.Context) {
+		flags, err := checker.CheckSLA(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				l.Error().Err(err).Msg("worker - SLA - CheckSLA")
+			}
+			return
+		}
+
+		for _, f := range flags {
+			event := l.Warn()
+			if f.SLAStatus == entity.SLABreached {
+				event = l.Error()
+			}
+			event.Int64("callID", f.CallID).
+				Int64("userID", f.UserID).
+				Time("dueAt", f.DueAt).
+				Str("sla", f.SLAStatus).
+				Msg("Call SLA flagged")
+		}
+	})
+}
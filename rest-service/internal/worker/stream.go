@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type CallsListener interface {
+	ListenCallChanges(context.Context) error
+}
+
+// NewStream returns a worker that passes call changes to the open streams
+// and listens again retry after the database connection is lost.
+func NewStream(listener CallsListener, retry time.Duration, l zerolog.Logger) *Worker {
+	return New(retry, func(ctx context.Context) {
+		if err := listener.ListenCallChanges(ctx); err != nil && ctx.Err() == nil {
+			l.Error().Err(err).Msg("worker - Stream - ListenCallChanges")
+		}
+	})
+}
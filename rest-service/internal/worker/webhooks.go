@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+
+	"github.com/rs/zerolog"
+)
+
+type WebhookDeliverer interface {
+	DeliverWebhooks(context.Context) ([]entity.WebhookDelivery, error)
+}
+
+// NewWebhooks returns a worker that periodically sends the due webhook
+// deliveries.
+func NewWebhooks(deliverer WebhookDeliverer, interval time.Duration, l zerolog.Logger) *Worker {
+	return New(interval, func(ctx context.Context) {
+		// Deliveries may have been sent even if saving some outcomes failed.
+		deliveries, err := deliverer.DeliverWebhooks(ctx)
+		if err != nil && ctx.Err() == nil {
+			l.Error().Err(err).Msg("worker - Webhooks - DeliverWebhooks")
+		}
+
+		for _, d := range deliveries {
+			event := l.Debug()
+			switch d.Status {
+			case entity.DeliveryPending:
+				event = l.Warn()
+			case entity.DeliveryFailed:
+				event = l.Error()
+			}
+			if d.LastStatusCode != nil {
+				event = event.Int("statusCode", *d.LastStatusCode)
+			}
+			if d.LastError != nil {
+				event = event.Str("error", *d.LastError)
+			}
+			event.Int64("deliveryID", d.ID).
+				Int64("webhookID", d.WebhookID).
+				Str("event", d.Event).
+				Int("attempt", d.Attempts).
+				Str("status", d.Status).
+				Msg("Webhook delivery attempted")
+		}
+	})
+}
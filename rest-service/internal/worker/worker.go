@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// Worker runs a task right after Start and then every interval until Stop.
+type Worker struct {
+	task     func(context.Context)
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func New(interval time.Duration, task func(context.Context)) *Worker {
+	return &Worker{
+		task:     task,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+}
+
+func (w *Worker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.task(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop cancels a running task and waits for the worker to exit.
+func (w *Worker) Stop() {
+	w.cancel()
+	<-w.done
+}
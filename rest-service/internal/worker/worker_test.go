@@ -0,0 +1,204 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"calls-service/rest-service/internal/entity"
+	"calls-service/rest-service/internal/worker"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type checkerFunc func(context.Context) ([]entity.SLAFlag, error)
+
+func (f checkerFunc) CheckSLA(ctx context.Context) ([]entity.SLAFlag, error) {
+	return f(ctx)
+}
+
+func TestSLAWorker(t *testing.T) {
+	var checks atomic.Int32
+	checked := make(chan struct{}, 1)
+
+	w := worker.NewSLA(checkerFunc(func(ctx context.Context) ([]entity.SLAFlag, error) {
+		checks.Add(1)
+		select {
+		case checked <- struct{}{}:
+		default:
+		}
+		return []entity.SLAFlag{{CallID: 1, SLAStatus: entity.SLABreached, DueAt: time.Now()}}, nil
+	}), time.Hour, zerolog.Nop())
+
+	w.Start()
+
+	select {
+	case <-checked:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not check SLA on start")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		w.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+
+	assert.Equal(t, int32(1), checks.Load())
+}
+
+type purgerFunc func(context.Context, time.Duration) (int64, error)
+
+func (f purgerFunc) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	return f(ctx, retention)
+}
+
+func TestPurgeWorker(t *testing.T) {
+	retentions := make(chan time.Duration, 1)
+
+	w := worker.NewPurge(purgerFunc(func(ctx context.Context, retention time.Duration) (int64, error) {
+		select {
+		case retentions <- retention:
+		default:
+		}
+		return 2, nil
+	}), 30*24*time.Hour, time.Hour, zerolog.Nop())
+
+	w.Start()
+	defer w.Stop()
+
+	select {
+	case retention := <-retentions:
+		assert.Equal(t, 30*24*time.Hour, retention)
+	case <-time.After(time.Second):
+		t.Fatal("worker did not purge trash on start")
+	}
+}
+
+type reminderFunc func(context.Context) ([]entity.CallbackDue, error)
+
+func (f reminderFunc) RemindCallbacks(ctx context.Context) ([]entity.CallbackDue, error) {
+	return f(ctx)
+}
+
+func TestCallbacksWorker(t *testing.T) {
+	reminded := make(chan struct{}, 1)
+	assigneeID := int64(2)
+
+	w := worker.NewCallbacks(reminderFunc(func(ctx context.Context) ([]entity.CallbackDue, error) {
+		select {
+		case reminded <- struct{}{}:
+		default:
+		}
+		return []entity.CallbackDue{{CallID: 1, UserID: 1, AssigneeID: &assigneeID, CallbackAt: time.Now(), Timezone: "Europe/Moscow"}}, nil
+	}), time.Hour, zerolog.Nop())
+
+	w.Start()
+	defer w.Stop()
+
+	select {
+	case <-reminded:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not check callbacks on start")
+	}
+}
+
+type delivererFunc func(context.Context) ([]entity.WebhookDelivery, error)
+
+func (f delivererFunc) DeliverWebhooks(ctx context.Context) ([]entity.WebhookDelivery, error) {
+	return f(ctx)
+}
+
+func TestWebhooksWorker(t *testing.T) {
+	delivered := make(chan struct{}, 1)
+	statusCode := 500
+	lastError := "unexpected response status 500"
+
+	w := worker.NewWebhooks(delivererFunc(func(ctx context.Context) ([]entity.WebhookDelivery, error) {
+		select {
+		case delivered <- struct{}{}:
+		default:
+		}
+		return []entity.WebhookDelivery{
+			{ID: 1, WebhookID: 1, Event: entity.WebhookCallCreated, Status: entity.DeliveryDelivered, Attempts: 1},
+			{ID: 2, WebhookID: 1, Event: entity.WebhookCallDeleted, Status: entity.DeliveryFailed, Attempts: 8, LastStatusCode: &statusCode, LastError: &lastError},
+		}, nil
+	}), time.Hour, zerolog.Nop())
+
+	w.Start()
+	defer w.Stop()
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not deliver webhooks on start")
+	}
+}
+
+type relayFunc func(context.Context) (int, error)
+
+func (f relayFunc) RelayOutbox(ctx context.Context) (int, error) {
+	return f(ctx)
+}
+
+func TestOutboxWorker(t *testing.T) {
+	relayed := make(chan struct{}, 1)
+
+	w := worker.NewOutbox(relayFunc(func(ctx context.Context) (int, error) {
+		select {
+		case relayed <- struct{}{}:
+		default:
+		}
+		return 3, nil
+	}), time.Hour, zerolog.Nop())
+
+	w.Start()
+	defer w.Stop()
+
+	select {
+	case <-relayed:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not relay outbox on start")
+	}
+}
+
+type listenerFunc func(context.Context) error
+
+func (f listenerFunc) ListenCallChanges(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestStreamWorker(t *testing.T) {
+	var listens atomic.Int32
+	listening := make(chan struct{}, 2)
+
+	// The worker listens again after the connection is lost.
+	w := worker.NewStream(listenerFunc(func(ctx context.Context) error {
+		if listens.Add(1) > 1 {
+			listening <- struct{}{}
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return errors.New("connection lost")
+	}), 10*time.Millisecond, zerolog.Nop())
+
+	w.Start()
+
+	select {
+	case <-listening:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not listen again")
+	}
+
+	w.Stop()
+	assert.Equal(t, int32(2), listens.Load())
+}
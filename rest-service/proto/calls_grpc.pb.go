@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: rest-service/proto/calls.proto
+
+package callspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CallsService_Create_FullMethodName       = "/calls.CallsService/Create"
+	CallsService_Get_FullMethodName          = "/calls.CallsService/Get"
+	CallsService_List_FullMethodName         = "/calls.CallsService/List"
+	CallsService_UpdateStatus_FullMethodName = "/calls.CallsService/UpdateStatus"
+	CallsService_Delete_FullMethodName       = "/calls.CallsService/Delete"
+	CallsService_Watch_FullMethodName        = "/calls.CallsService/Watch"
+)
+
+// CallsServiceClient is the client API for CallsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CallsService works with the calls of the user of the bearer token passed in
+// the authorization metadata, as the REST API does.
+type CallsServiceClient interface {
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Call, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Call, error)
+	// List sends every call of the user that matches the filters, in order.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Call], error)
+	UpdateStatus(ctx context.Context, in *UpdateStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Delete moves the call to the trash.
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Watch sends the changes of the calls visible to the user until the client
+	// cancels it. It ends with UNAVAILABLE when the client falls too far behind
+	// or the service stops; the client should then reload the calls.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CallEvent], error)
+}
+
+type callsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCallsServiceClient(cc grpc.ClientConnInterface) CallsServiceClient {
+	return &callsServiceClient{cc}
+}
+
+func (c *callsServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Call, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Call)
+	err := c.cc.Invoke(ctx, CallsService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callsServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Call, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Call)
+	err := c.cc.Invoke(ctx, CallsService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callsServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Call], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CallsService_ServiceDesc.Streams[0], CallsService_List_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListRequest, Call]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CallsService_ListClient = grpc.ServerStreamingClient[Call]
+
+func (c *callsServiceClient) UpdateStatus(ctx context.Context, in *UpdateStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CallsService_UpdateStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callsServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CallsService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callsServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CallEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CallsService_ServiceDesc.Streams[1], CallsService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, CallEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CallsService_WatchClient = grpc.ServerStreamingClient[CallEvent]
+
+// CallsServiceServer is the server API for CallsService service.
+// All implementations must embed UnimplementedCallsServiceServer
+// for forward compatibility.
+//
+// CallsService works with the calls of the user of the bearer token passed in
+// the authorization metadata, as the REST API does.
+type CallsServiceServer interface {
+	Create(context.Context, *CreateRequest) (*Call, error)
+	Get(context.Context, *GetRequest) (*Call, error)
+	// List sends every call of the user that matches the filters, in order.
+	List(*ListRequest, grpc.ServerStreamingServer[Call]) error
+	UpdateStatus(context.Context, *UpdateStatusRequest) (*emptypb.Empty, error)
+	// Delete moves the call to the trash.
+	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// Watch sends the changes of the calls visible to the user until the client
+	// cancels it. It ends with UNAVAILABLE when the client falls too far behind
+	// or the service stops; the client should then reload the calls.
+	Watch(*WatchRequest, grpc.ServerStreamingServer[CallEvent]) error
+	mustEmbedUnimplementedCallsServiceServer()
+}
+
+// UnimplementedCallsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCallsServiceServer struct{}
+
+func (UnimplementedCallsServiceServer) Create(context.Context, *CreateRequest) (*Call, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedCallsServiceServer) Get(context.Context, *GetRequest) (*Call, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCallsServiceServer) List(*ListRequest, grpc.ServerStreamingServer[Call]) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedCallsServiceServer) UpdateStatus(context.Context, *UpdateStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
+func (UnimplementedCallsServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCallsServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[CallEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedCallsServiceServer) mustEmbedUnimplementedCallsServiceServer() {}
+func (UnimplementedCallsServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeCallsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CallsServiceServer will
+// result in compilation errors.
+type UnsafeCallsServiceServer interface {
+	mustEmbedUnimplementedCallsServiceServer()
+}
+
+func RegisterCallsServiceServer(s grpc.ServiceRegistrar, srv CallsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCallsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CallsService_ServiceDesc, srv)
+}
+
+func _CallsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallsServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallsService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallsServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallsService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallsServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallsService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallsServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallsService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CallsServiceServer).List(m, &grpc.GenericServerStream[ListRequest, Call]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CallsService_ListServer = grpc.ServerStreamingServer[Call]
+
+func _CallsService_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallsServiceServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallsService_UpdateStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallsServiceServer).UpdateStatus(ctx, req.(*UpdateStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallsService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallsServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallsService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallsServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallsService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CallsServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, CallEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CallsService_WatchServer = grpc.ServerStreamingServer[CallEvent]
+
+// CallsService_ServiceDesc is the grpc.ServiceDesc for CallsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CallsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calls.CallsService",
+	HandlerType: (*CallsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CallsService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CallsService_Get_Handler,
+		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _CallsService_UpdateStatus_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CallsService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _CallsService_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _CallsService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rest-service/proto/calls.proto",
+}